@@ -0,0 +1,127 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/brettlangdon/gython/gython"
+)
+
+// Arguments are the parameters of a function or lambda
+type Arguments struct {
+	Args       []*Arg
+	VarArg     *Arg
+	KwOnlyArgs []*Arg
+	// KwDefaults has an entry for every keyword only argument, nil when it has no default
+	KwDefaults []Expression
+	KwArg      *Arg
+	Defaults   []Expression
+}
+
+func NewArguments() *Arguments {
+	return &Arguments{
+		Args:       make([]*Arg, 0),
+		KwOnlyArgs: make([]*Arg, 0),
+		KwDefaults: make([]Expression, 0),
+		Defaults:   make([]Expression, 0),
+	}
+}
+
+func (arguments *Arguments) node()          {}
+func (arguments *Arguments) String() string { return Dump(arguments, false) }
+func (arguments *Arguments) dump(dumper *dumper) string {
+	return fmt.Sprintf(
+		"arguments(args=%s, vararg=%s, kwonlyargs=%s, kw_defaults=%s, kwarg=%s, defaults=%s)",
+		dumper.args(arguments.Args),
+		dumper.arg(arguments.VarArg),
+		dumper.args(arguments.KwOnlyArgs),
+		dumper.expressions(arguments.KwDefaults),
+		dumper.arg(arguments.KwArg),
+		dumper.expressions(arguments.Defaults),
+	)
+}
+func (arguments *Arguments) Fields() []string {
+	return []string{"args", "vararg", "kwonlyargs", "kw_defaults", "kwarg", "defaults"}
+}
+func (arguments *Arguments) Attributes() []string { return nil }
+func (arguments *Arguments) fieldPointers() []interface{} {
+	return []interface{}{&arguments.Args, &arguments.VarArg, &arguments.KwOnlyArgs, &arguments.KwDefaults, &arguments.KwArg, &arguments.Defaults}
+}
+
+func (dumper *dumper) arguments(arguments *Arguments) string {
+	if arguments == nil {
+		return "None"
+	}
+	return dumper.node(arguments)
+}
+
+// Arg is a single parameter with its optional annotation
+type Arg struct {
+	Position
+
+	Arg        *gython.Unicode
+	Annotation Expression
+}
+
+func NewArg(arg string, annotation Expression) *Arg {
+	return &Arg{
+		Arg:        gython.NewUnicode([]byte(arg)),
+		Annotation: annotation,
+	}
+}
+
+func (arg *Arg) node()          {}
+func (arg *Arg) String() string { return Dump(arg, false) }
+func (arg *Arg) dump(dumper *dumper) string {
+	return fmt.Sprintf("arg(arg=%s, annotation=%s)", dumpIdentifier(arg.Arg), dumper.node(arg.Annotation))
+}
+func (arg *Arg) Fields() []string { return []string{"arg", "annotation"} }
+func (arg *Arg) fieldPointers() []interface{} {
+	return []interface{}{&arg.Arg, &arg.Annotation}
+}
+
+func (dumper *dumper) arg(arg *Arg) string {
+	if arg == nil {
+		return "None"
+	}
+	return dumper.node(arg)
+}
+
+func (dumper *dumper) args(args []*Arg) string {
+	items := make([]string, 0, len(args))
+	for _, arg := range args {
+		items = append(items, dumper.arg(arg))
+	}
+	return dumpList(items)
+}
+
+// Keyword is a keyword argument of a call, Arg is nil for **kwargs
+type Keyword struct {
+	Arg   *gython.Unicode
+	Value Expression
+}
+
+func NewKeyword(arg *gython.Unicode, value Expression) *Keyword {
+	return &Keyword{
+		Arg:   arg,
+		Value: value,
+	}
+}
+
+func (keyword *Keyword) node()          {}
+func (keyword *Keyword) String() string { return Dump(keyword, false) }
+func (keyword *Keyword) dump(dumper *dumper) string {
+	return fmt.Sprintf("keyword(arg=%s, value=%s)", dumpIdentifier(keyword.Arg), dumper.node(keyword.Value))
+}
+func (keyword *Keyword) Fields() []string     { return []string{"arg", "value"} }
+func (keyword *Keyword) Attributes() []string { return nil }
+func (keyword *Keyword) fieldPointers() []interface{} {
+	return []interface{}{&keyword.Arg, &keyword.Value}
+}
+
+func (dumper *dumper) keywords(keywords []*Keyword) string {
+	items := make([]string, 0, len(keywords))
+	for _, keyword := range keywords {
+		items = append(items, dumper.node(keyword))
+	}
+	return dumpList(items)
+}
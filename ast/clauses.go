@@ -0,0 +1,98 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/brettlangdon/gython/gython"
+)
+
+// ExceptHandler is an except clause of a Try, Type and Name are nil for a bare except
+type ExceptHandler struct {
+	Position
+
+	Type Expression
+	Name *gython.Unicode
+	Body []Statement
+}
+
+func NewExceptHandler(typ Expression, name *gython.Unicode, body []Statement) *ExceptHandler {
+	return &ExceptHandler{
+		Type: typ,
+		Name: name,
+		Body: body,
+	}
+}
+
+func (handler *ExceptHandler) node()          {}
+func (handler *ExceptHandler) String() string { return Dump(handler, false) }
+func (handler *ExceptHandler) dump(dumper *dumper) string {
+	return fmt.Sprintf("ExceptHandler(type=%s, name=%s, body=%s)", dumper.node(handler.Type), dumpIdentifier(handler.Name), dumper.statements(handler.Body))
+}
+func (handler *ExceptHandler) Fields() []string { return []string{"type", "name", "body"} }
+func (handler *ExceptHandler) fieldPointers() []interface{} {
+	return []interface{}{&handler.Type, &handler.Name, &handler.Body}
+}
+
+// Alias is a name imported by Import or ImportFrom, AsName is nil without "as"
+type Alias struct {
+	Name   *gython.Unicode
+	AsName *gython.Unicode
+}
+
+func NewAlias(name string, asName *gython.Unicode) *Alias {
+	return &Alias{
+		Name:   gython.NewUnicode([]byte(name)),
+		AsName: asName,
+	}
+}
+
+func (alias *Alias) node()          {}
+func (alias *Alias) String() string { return Dump(alias, false) }
+func (alias *Alias) dump(dumper *dumper) string {
+	return fmt.Sprintf("alias(name=%s, asname=%s)", dumpIdentifier(alias.Name), dumpIdentifier(alias.AsName))
+}
+func (alias *Alias) Fields() []string     { return []string{"name", "asname"} }
+func (alias *Alias) Attributes() []string { return nil }
+func (alias *Alias) fieldPointers() []interface{} {
+	return []interface{}{&alias.Name, &alias.AsName}
+}
+
+func (dumper *dumper) aliases(aliases []*Alias) string {
+	items := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		items = append(items, dumper.node(alias))
+	}
+	return dumpList(items)
+}
+
+// WithItem is one context manager of a With, OptionalVars is the target after "as"
+type WithItem struct {
+	ContextExpr  Expression
+	OptionalVars Expression
+}
+
+func NewWithItem(contextExpr Expression, optionalVars Expression) *WithItem {
+	return &WithItem{
+		ContextExpr:  contextExpr,
+		OptionalVars: optionalVars,
+	}
+}
+
+func (withItem *WithItem) node()          {}
+func (withItem *WithItem) String() string { return Dump(withItem, false) }
+func (withItem *WithItem) dump(dumper *dumper) string {
+	return fmt.Sprintf("withitem(context_expr=%s, optional_vars=%s)", dumper.node(withItem.ContextExpr), dumper.node(withItem.OptionalVars))
+}
+func (withItem *WithItem) Fields() []string     { return []string{"context_expr", "optional_vars"} }
+func (withItem *WithItem) Attributes() []string { return nil }
+func (withItem *WithItem) fieldPointers() []interface{} {
+	return []interface{}{&withItem.ContextExpr, &withItem.OptionalVars}
+}
+
+func (dumper *dumper) withItems(items []*WithItem) string {
+	dumped := make([]string, 0, len(items))
+	for _, item := range items {
+		dumped = append(dumped, dumper.node(item))
+	}
+	return dumpList(dumped)
+}
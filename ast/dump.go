@@ -0,0 +1,88 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brettlangdon/gython/gython"
+)
+
+// Dump returns the ast.dump representation of node, with the lineno and
+// col_offset of every positioned node when includeAttributes is set
+func Dump(node Node, includeAttributes bool) string {
+	dumper := &dumper{includeAttributes: includeAttributes}
+	return dumper.node(node)
+}
+
+// dumper carries the options of a Dump down through the tree
+type dumper struct {
+	includeAttributes bool
+}
+
+// dumpable is a node whose representation includes other nodes
+type dumpable interface {
+	Node
+	dump(dumper *dumper) string
+}
+
+// node returns the ast.dump representation of an optional node
+func (dumper *dumper) node(node Node) string {
+	if node == nil {
+		return "None"
+	}
+
+	var dumped string
+	if withFields, isDumpable := node.(dumpable); isDumpable {
+		dumped = withFields.dump(dumper)
+	} else {
+		dumped = node.String()
+	}
+
+	positioned, isPositioned := node.(Positioned)
+	if !dumper.includeAttributes || !isPositioned {
+		return dumped
+	}
+	// Like ast.dump the attributes follow the fields, separated by a space when there are no fields
+	separator := ", "
+	if strings.HasSuffix(dumped, "()") {
+		separator = " "
+	}
+	pos := positioned.Pos()
+	return fmt.Sprintf("%s%slineno=%d, col_offset=%d)", dumped[:len(dumped)-1], separator, pos.Lineno, pos.ColOffset)
+}
+
+// dumpIdentifier returns the repr of an optional identifier
+func dumpIdentifier(identifier *gython.Unicode) string {
+	if identifier == nil {
+		return "None"
+	}
+	return identifier.Repr()
+}
+
+func dumpList(items []string) string {
+	return "[" + strings.Join(items, ", ") + "]"
+}
+
+func (dumper *dumper) expressions(exprs []Expression) string {
+	items := make([]string, 0, len(exprs))
+	for _, expr := range exprs {
+		items = append(items, dumper.node(expr))
+	}
+	return dumpList(items)
+}
+
+func (dumper *dumper) statements(stmts []Statement) string {
+	items := make([]string, 0, len(stmts))
+	for _, stmt := range stmts {
+		items = append(items, dumper.node(stmt))
+	}
+	return dumpList(items)
+}
+
+func dumpIdentifiers(identifiers []*gython.Unicode) string {
+	items := make([]string, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		items = append(items, dumpIdentifier(identifier))
+	}
+	return dumpList(items)
+}
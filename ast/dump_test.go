@@ -0,0 +1,49 @@
+package ast
+
+import "testing"
+
+func TestDump(t *testing.T) {
+	// The expected dumps are from Python's ast.dump
+	tests := []struct {
+		source string
+		dump   string
+	}{
+		{"a + b * c - d / e // f % g @ h\n", "Module(body=[Expr(value=BinOp(left=BinOp(left=Name(id='a', ctx=Load()), op=Add(), right=BinOp(left=Name(id='b', ctx=Load()), op=Mult(), right=Name(id='c', ctx=Load()))), op=Sub(), right=BinOp(left=BinOp(left=BinOp(left=BinOp(left=Name(id='d', ctx=Load()), op=Div(), right=Name(id='e', ctx=Load())), op=FloorDiv(), right=Name(id='f', ctx=Load())), op=Mod(), right=Name(id='g', ctx=Load())), op=MatMult(), right=Name(id='h', ctx=Load()))))])"},
+		{"a ** -b\n", "Module(body=[Expr(value=BinOp(left=Name(id='a', ctx=Load()), op=Pow(), right=UnaryOp(op=USub(), operand=Name(id='b', ctx=Load()))))])"},
+		{"a << b >> c | d ^ e & f\n", "Module(body=[Expr(value=BinOp(left=BinOp(left=BinOp(left=Name(id='a', ctx=Load()), op=LShift(), right=Name(id='b', ctx=Load())), op=RShift(), right=Name(id='c', ctx=Load())), op=BitOr(), right=BinOp(left=Name(id='d', ctx=Load()), op=BitXor(), right=BinOp(left=Name(id='e', ctx=Load()), op=BitAnd(), right=Name(id='f', ctx=Load())))))])"},
+		{"not a and b or c\n", "Module(body=[Expr(value=BoolOp(op=Or(), values=[BoolOp(op=And(), values=[UnaryOp(op=Not(), operand=Name(id='a', ctx=Load())), Name(id='b', ctx=Load())]), Name(id='c', ctx=Load())]))])"},
+		{"-a + +b - ~c\n", "Module(body=[Expr(value=BinOp(left=BinOp(left=UnaryOp(op=USub(), operand=Name(id='a', ctx=Load())), op=Add(), right=UnaryOp(op=UAdd(), operand=Name(id='b', ctx=Load()))), op=Sub(), right=UnaryOp(op=Invert(), operand=Name(id='c', ctx=Load()))))])"},
+		{"a < b <= c == d != e > f >= g is h is not i in j not in k\n", "Module(body=[Expr(value=Compare(left=Name(id='a', ctx=Load()), ops=[Lt(), LtE(), Eq(), NotEq(), Gt(), GtE(), Is(), IsNot(), In(), NotIn()], comparators=[Name(id='b', ctx=Load()), Name(id='c', ctx=Load()), Name(id='d', ctx=Load()), Name(id='e', ctx=Load()), Name(id='f', ctx=Load()), Name(id='g', ctx=Load()), Name(id='h', ctx=Load()), Name(id='i', ctx=Load()), Name(id='j', ctx=Load()), Name(id='k', ctx=Load())]))])"},
+		{"f(a, *b, c=1, **d)\n", "Module(body=[Expr(value=Call(func=Name(id='f', ctx=Load()), args=[Name(id='a', ctx=Load()), Starred(value=Name(id='b', ctx=Load()), ctx=Load())], keywords=[keyword(arg='c', value=Num(n=1)), keyword(arg=None, value=Name(id='d', ctx=Load()))]))])"},
+		{"a.b.c\n", "Module(body=[Expr(value=Attribute(value=Attribute(value=Name(id='a', ctx=Load()), attr='b', ctx=Load()), attr='c', ctx=Load()))])"},
+		{"a[1]\n", "Module(body=[Expr(value=Subscript(value=Name(id='a', ctx=Load()), slice=Index(value=Num(n=1)), ctx=Load()))])"},
+		{"a[1:2]\n", "Module(body=[Expr(value=Subscript(value=Name(id='a', ctx=Load()), slice=Slice(lower=Num(n=1), upper=Num(n=2), step=None), ctx=Load()))])"},
+		{"a[::3]\n", "Module(body=[Expr(value=Subscript(value=Name(id='a', ctx=Load()), slice=Slice(lower=None, upper=None, step=Num(n=3)), ctx=Load()))])"},
+		{"a[1:2, 3]\n", "Module(body=[Expr(value=Subscript(value=Name(id='a', ctx=Load()), slice=ExtSlice(dims=[Slice(lower=Num(n=1), upper=Num(n=2), step=None), Index(value=Num(n=3))]), ctx=Load()))])"},
+		{"a[...]\n", "Module(body=[Expr(value=Subscript(value=Name(id='a', ctx=Load()), slice=Index(value=Ellipsis()), ctx=Load()))])"},
+		{"'s' 't'\n", "Module(body=[Expr(value=Str(s='st'))])"},
+		{"b'x' b'y'\n", "Module(body=[Expr(value=Bytes(s=b'xy'))])"},
+		{"None, True, False, ...\n", "Module(body=[Expr(value=Tuple(elts=[NameConstant(value=None), NameConstant(value=True), NameConstant(value=False), Ellipsis()], ctx=Load()))])"},
+		{"*a, b = c\n", "Module(body=[Assign(targets=[Tuple(elts=[Starred(value=Name(id='a', ctx=Store()), ctx=Store()), Name(id='b', ctx=Store())], ctx=Store())], value=Name(id='c', ctx=Load()))])"},
+		{"[a, [b]]\n", "Module(body=[Expr(value=List(elts=[Name(id='a', ctx=Load()), List(elts=[Name(id='b', ctx=Load())], ctx=Load())], ctx=Load()))])"},
+		{"(a,)\n", "Module(body=[Expr(value=Tuple(elts=[Name(id='a', ctx=Load())], ctx=Load()))])"},
+		{"{a, b}\n", "Module(body=[Expr(value=Set(elts=[Name(id='a', ctx=Load()), Name(id='b', ctx=Load())]))])"},
+		{"{a: b, **c}\n", "Module(body=[Expr(value=Dict(keys=[Name(id='a', ctx=Load()), None], values=[Name(id='b', ctx=Load()), Name(id='c', ctx=Load())]))])"},
+		{"a if b else c\n", "Module(body=[Expr(value=IfExp(test=Name(id='b', ctx=Load()), body=Name(id='a', ctx=Load()), orelse=Name(id='c', ctx=Load())))])"},
+		{"lambda a, b=1, *c, d, e=2, **f: a\n", "Module(body=[Expr(value=Lambda(args=arguments(args=[arg(arg='a', annotation=None), arg(arg='b', annotation=None)], vararg=arg(arg='c', annotation=None), kwonlyargs=[arg(arg='d', annotation=None), arg(arg='e', annotation=None)], kw_defaults=[None, Num(n=2)], kwarg=arg(arg='f', annotation=None), defaults=[Num(n=1)]), body=Name(id='a', ctx=Load())))])"},
+		{"[a for b in c if d for e in f]\n", "Module(body=[Expr(value=ListComp(elt=Name(id='a', ctx=Load()), generators=[comprehension(target=Name(id='b', ctx=Store()), iter=Name(id='c', ctx=Load()), ifs=[Name(id='d', ctx=Load())]), comprehension(target=Name(id='e', ctx=Store()), iter=Name(id='f', ctx=Load()), ifs=[])]))])"},
+		{"{a for b in c}\n", "Module(body=[Expr(value=SetComp(elt=Name(id='a', ctx=Load()), generators=[comprehension(target=Name(id='b', ctx=Store()), iter=Name(id='c', ctx=Load()), ifs=[])]))])"},
+		{"{a: b for c, d in e}\n", "Module(body=[Expr(value=DictComp(key=Name(id='a', ctx=Load()), value=Name(id='b', ctx=Load()), generators=[comprehension(target=Tuple(elts=[Name(id='c', ctx=Store()), Name(id='d', ctx=Store())], ctx=Store()), iter=Name(id='e', ctx=Load()), ifs=[])]))])"},
+		{"(a for b in c)\n", "Module(body=[Expr(value=GeneratorExp(elt=Name(id='a', ctx=Load()), generators=[comprehension(target=Name(id='b', ctx=Store()), iter=Name(id='c', ctx=Load()), ifs=[])]))])"},
+		{"x = yield\n", "Module(body=[Assign(targets=[Name(id='x', ctx=Store())], value=Yield(value=None))])"},
+		{"x = yield a\n", "Module(body=[Assign(targets=[Name(id='x', ctx=Store())], value=Yield(value=Name(id='a', ctx=Load())))])"},
+		{"x = yield from a\n", "Module(body=[Assign(targets=[Name(id='x', ctx=Store())], value=YieldFrom(value=Name(id='a', ctx=Load())))])"},
+		{"1.5e3, 0x10, 3j\n", "Module(body=[Expr(value=Tuple(elts=[Num(n=1500.0), Num(n=16), Num(n=3j)], ctx=Load()))])"},
+		{"'caf\\xe9'\n", "Module(body=[Expr(value=Str(s='café'))])"},
+	}
+	for _, test := range tests {
+		if dump := Dump(parseSource(t, test.source), false); dump != test.dump {
+			t.Errorf("Dump(%q) =\n%s\nwant\n%s", test.source, dump, test.dump)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/brettlangdon/gython/grammar"
+)
+
+// SyntaxError is an error found while lowering a grammar tree, like the errors ast.c raises with ast_error
+type SyntaxError struct {
+	Message   string
+	Lineno    int
+	ColOffset int
+}
+
+// newSyntaxError returns a SyntaxError placed at the start of root
+func newSyntaxError(root grammar.Node, message string) *SyntaxError {
+	err := &SyntaxError{Message: message}
+	if tok := startToken(root); tok != nil {
+		err.Lineno = tok.LineStart
+		err.ColOffset = tok.ColumnStart
+	}
+	return err
+}
+
+// syntaxErrorAt returns a SyntaxError placed at an already lowered node
+func syntaxErrorAt(node Positioned, message string) *SyntaxError {
+	position := node.Pos()
+	return &SyntaxError{Message: message, Lineno: position.Lineno, ColOffset: position.ColOffset}
+}
+
+// unexpectedNode reports a grammar node the lowering does not handle where it was found
+func unexpectedNode(root grammar.Node) *SyntaxError {
+	return newSyntaxError(root, fmt.Sprintf("unexpected %s node", root.Name()))
+}
+
+func (err *SyntaxError) Error() string {
+	if err.Lineno == 0 {
+		return err.Message
+	}
+	return fmt.Sprintf("%d:%d: %s", err.Lineno, err.ColOffset, err.Message)
+}
@@ -9,9 +9,619 @@ import (
 type Expression interface {
 	Node
 	expr()
+	Pos() *Position
+}
+
+// BoolOp is a chain of "and" or "or" operations, e.g. a or b or c
+type BoolOp struct {
+	Position
+
+	Operator BooleanOperator
+	Values   []Expression
+}
+
+func NewBoolOp(op BooleanOperator, values []Expression) *BoolOp {
+	return &BoolOp{
+		Operator: op,
+		Values:   values,
+	}
+}
+
+func (boolOp *BoolOp) node()          {}
+func (boolOp *BoolOp) expr()          {}
+func (boolOp *BoolOp) String() string { return Dump(boolOp, false) }
+func (boolOp *BoolOp) dump(dumper *dumper) string {
+	return fmt.Sprintf("BoolOp(op=%s, values=%s)", dumper.node(boolOp.Operator), dumper.expressions(boolOp.Values))
+}
+func (boolOp *BoolOp) Fields() []string { return []string{"op", "values"} }
+func (boolOp *BoolOp) fieldPointers() []interface{} {
+	return []interface{}{&boolOp.Operator, &boolOp.Values}
+}
+
+type BinOp struct {
+	Position
+
+	Left     Expression
+	Operator Operator
+	Right    Expression
+}
+
+func NewBinOp(left Expression, op Operator, right Expression) *BinOp {
+	return &BinOp{
+		Left:     left,
+		Operator: op,
+		Right:    right,
+	}
+}
+
+func (binOp *BinOp) node()          {}
+func (binOp *BinOp) expr()          {}
+func (binOp *BinOp) String() string { return Dump(binOp, false) }
+func (binOp *BinOp) dump(dumper *dumper) string {
+	return fmt.Sprintf("BinOp(left=%s, op=%s, right=%s)", dumper.node(binOp.Left), dumper.node(binOp.Operator), dumper.node(binOp.Right))
+}
+func (binOp *BinOp) Fields() []string { return []string{"left", "op", "right"} }
+func (binOp *BinOp) fieldPointers() []interface{} {
+	return []interface{}{&binOp.Left, &binOp.Operator, &binOp.Right}
+}
+
+type UnaryOp struct {
+	Position
+
+	Operator UnaryOperator
+	Operand  Expression
+}
+
+func NewUnaryOp(op UnaryOperator, operand Expression) *UnaryOp {
+	return &UnaryOp{
+		Operator: op,
+		Operand:  operand,
+	}
+}
+
+func (unaryOp *UnaryOp) node()          {}
+func (unaryOp *UnaryOp) expr()          {}
+func (unaryOp *UnaryOp) String() string { return Dump(unaryOp, false) }
+func (unaryOp *UnaryOp) dump(dumper *dumper) string {
+	return fmt.Sprintf("UnaryOp(op=%s, operand=%s)", dumper.node(unaryOp.Operator), dumper.node(unaryOp.Operand))
+}
+func (unaryOp *UnaryOp) Fields() []string { return []string{"op", "operand"} }
+func (unaryOp *UnaryOp) fieldPointers() []interface{} {
+	return []interface{}{&unaryOp.Operator, &unaryOp.Operand}
+}
+
+type Lambda struct {
+	Position
+
+	Args *Arguments
+	Body Expression
+}
+
+func NewLambda(args *Arguments, body Expression) *Lambda {
+	return &Lambda{
+		Args: args,
+		Body: body,
+	}
+}
+
+func (lambda *Lambda) node()          {}
+func (lambda *Lambda) expr()          {}
+func (lambda *Lambda) String() string { return Dump(lambda, false) }
+func (lambda *Lambda) dump(dumper *dumper) string {
+	return fmt.Sprintf("Lambda(args=%s, body=%s)", dumper.arguments(lambda.Args), dumper.node(lambda.Body))
+}
+func (lambda *Lambda) Fields() []string { return []string{"args", "body"} }
+func (lambda *Lambda) fieldPointers() []interface{} {
+	return []interface{}{&lambda.Args, &lambda.Body}
+}
+
+// IfExp is a conditional expression, body if test else orelse
+type IfExp struct {
+	Position
+
+	Test   Expression
+	Body   Expression
+	OrElse Expression
+}
+
+func NewIfExp(test Expression, body Expression, orElse Expression) *IfExp {
+	return &IfExp{
+		Test:   test,
+		Body:   body,
+		OrElse: orElse,
+	}
+}
+
+func (ifExp *IfExp) node()          {}
+func (ifExp *IfExp) expr()          {}
+func (ifExp *IfExp) String() string { return Dump(ifExp, false) }
+func (ifExp *IfExp) dump(dumper *dumper) string {
+	return fmt.Sprintf("IfExp(test=%s, body=%s, orelse=%s)", dumper.node(ifExp.Test), dumper.node(ifExp.Body), dumper.node(ifExp.OrElse))
+}
+func (ifExp *IfExp) Fields() []string { return []string{"test", "body", "orelse"} }
+func (ifExp *IfExp) fieldPointers() []interface{} {
+	return []interface{}{&ifExp.Test, &ifExp.Body, &ifExp.OrElse}
+}
+
+// Dict is a dictionary display, a nil key unpacks the matching value with **
+type Dict struct {
+	Position
+
+	Keys   []Expression
+	Values []Expression
+}
+
+func NewDict(keys []Expression, values []Expression) *Dict {
+	return &Dict{
+		Keys:   keys,
+		Values: values,
+	}
+}
+
+func (dict *Dict) node()          {}
+func (dict *Dict) expr()          {}
+func (dict *Dict) String() string { return Dump(dict, false) }
+func (dict *Dict) dump(dumper *dumper) string {
+	return fmt.Sprintf("Dict(keys=%s, values=%s)", dumper.expressions(dict.Keys), dumper.expressions(dict.Values))
+}
+func (dict *Dict) Fields() []string { return []string{"keys", "values"} }
+func (dict *Dict) fieldPointers() []interface{} {
+	return []interface{}{&dict.Keys, &dict.Values}
+}
+
+type Set struct {
+	Position
+
+	Elements []Expression
+}
+
+func NewSet(elements []Expression) *Set {
+	return &Set{
+		Elements: elements,
+	}
+}
+
+func (set *Set) node()          {}
+func (set *Set) expr()          {}
+func (set *Set) String() string { return Dump(set, false) }
+func (set *Set) dump(dumper *dumper) string {
+	return fmt.Sprintf("Set(elts=%s)", dumper.expressions(set.Elements))
+}
+func (set *Set) Fields() []string { return []string{"elts"} }
+func (set *Set) fieldPointers() []interface{} {
+	return []interface{}{&set.Elements}
+}
+
+type ListComp struct {
+	Position
+
+	Element    Expression
+	Generators []*Comprehension
+}
+
+func NewListComp(element Expression, generators []*Comprehension) *ListComp {
+	return &ListComp{
+		Element:    element,
+		Generators: generators,
+	}
+}
+
+func (listComp *ListComp) node()          {}
+func (listComp *ListComp) expr()          {}
+func (listComp *ListComp) String() string { return Dump(listComp, false) }
+func (listComp *ListComp) dump(dumper *dumper) string {
+	return fmt.Sprintf("ListComp(elt=%s, generators=%s)", dumper.node(listComp.Element), dumper.comprehensions(listComp.Generators))
+}
+func (listComp *ListComp) Fields() []string { return []string{"elt", "generators"} }
+func (listComp *ListComp) fieldPointers() []interface{} {
+	return []interface{}{&listComp.Element, &listComp.Generators}
+}
+
+type SetComp struct {
+	Position
+
+	Element    Expression
+	Generators []*Comprehension
+}
+
+func NewSetComp(element Expression, generators []*Comprehension) *SetComp {
+	return &SetComp{
+		Element:    element,
+		Generators: generators,
+	}
+}
+
+func (setComp *SetComp) node()          {}
+func (setComp *SetComp) expr()          {}
+func (setComp *SetComp) String() string { return Dump(setComp, false) }
+func (setComp *SetComp) dump(dumper *dumper) string {
+	return fmt.Sprintf("SetComp(elt=%s, generators=%s)", dumper.node(setComp.Element), dumper.comprehensions(setComp.Generators))
+}
+func (setComp *SetComp) Fields() []string { return []string{"elt", "generators"} }
+func (setComp *SetComp) fieldPointers() []interface{} {
+	return []interface{}{&setComp.Element, &setComp.Generators}
+}
+
+type DictComp struct {
+	Position
+
+	Key        Expression
+	Value      Expression
+	Generators []*Comprehension
+}
+
+func NewDictComp(key Expression, value Expression, generators []*Comprehension) *DictComp {
+	return &DictComp{
+		Key:        key,
+		Value:      value,
+		Generators: generators,
+	}
+}
+
+func (dictComp *DictComp) node()          {}
+func (dictComp *DictComp) expr()          {}
+func (dictComp *DictComp) String() string { return Dump(dictComp, false) }
+func (dictComp *DictComp) dump(dumper *dumper) string {
+	return fmt.Sprintf(
+		"DictComp(key=%s, value=%s, generators=%s)",
+		dumper.node(dictComp.Key), dumper.node(dictComp.Value), dumper.comprehensions(dictComp.Generators),
+	)
+}
+func (dictComp *DictComp) Fields() []string { return []string{"key", "value", "generators"} }
+func (dictComp *DictComp) fieldPointers() []interface{} {
+	return []interface{}{&dictComp.Key, &dictComp.Value, &dictComp.Generators}
+}
+
+type GeneratorExp struct {
+	Position
+
+	Element    Expression
+	Generators []*Comprehension
+}
+
+func NewGeneratorExp(element Expression, generators []*Comprehension) *GeneratorExp {
+	return &GeneratorExp{
+		Element:    element,
+		Generators: generators,
+	}
+}
+
+func (generatorExp *GeneratorExp) node()          {}
+func (generatorExp *GeneratorExp) expr()          {}
+func (generatorExp *GeneratorExp) String() string { return Dump(generatorExp, false) }
+func (generatorExp *GeneratorExp) dump(dumper *dumper) string {
+	return fmt.Sprintf("GeneratorExp(elt=%s, generators=%s)", dumper.node(generatorExp.Element), dumper.comprehensions(generatorExp.Generators))
+}
+func (generatorExp *GeneratorExp) Fields() []string { return []string{"elt", "generators"} }
+func (generatorExp *GeneratorExp) fieldPointers() []interface{} {
+	return []interface{}{&generatorExp.Element, &generatorExp.Generators}
+}
+
+type Await struct {
+	Position
+
+	Value Expression
+}
+
+func NewAwait(value Expression) *Await {
+	return &Await{
+		Value: value,
+	}
+}
+
+func (await *Await) node()          {}
+func (await *Await) expr()          {}
+func (await *Await) String() string { return Dump(await, false) }
+func (await *Await) dump(dumper *dumper) string {
+	return fmt.Sprintf("Await(value=%s)", dumper.node(await.Value))
+}
+func (await *Await) Fields() []string { return []string{"value"} }
+func (await *Await) fieldPointers() []interface{} {
+	return []interface{}{&await.Value}
+}
+
+// Yield is a yield expression, Value is nil for a bare yield
+type Yield struct {
+	Position
+
+	Value Expression
+}
+
+func NewYield(value Expression) *Yield {
+	return &Yield{
+		Value: value,
+	}
+}
+
+func (yield *Yield) node()          {}
+func (yield *Yield) expr()          {}
+func (yield *Yield) String() string { return Dump(yield, false) }
+func (yield *Yield) dump(dumper *dumper) string {
+	return fmt.Sprintf("Yield(value=%s)", dumper.node(yield.Value))
+}
+func (yield *Yield) Fields() []string { return []string{"value"} }
+func (yield *Yield) fieldPointers() []interface{} {
+	return []interface{}{&yield.Value}
+}
+
+type YieldFrom struct {
+	Position
+
+	Value Expression
+}
+
+func NewYieldFrom(value Expression) *YieldFrom {
+	return &YieldFrom{
+		Value: value,
+	}
+}
+
+func (yieldFrom *YieldFrom) node()          {}
+func (yieldFrom *YieldFrom) expr()          {}
+func (yieldFrom *YieldFrom) String() string { return Dump(yieldFrom, false) }
+func (yieldFrom *YieldFrom) dump(dumper *dumper) string {
+	return fmt.Sprintf("YieldFrom(value=%s)", dumper.node(yieldFrom.Value))
+}
+func (yieldFrom *YieldFrom) Fields() []string { return []string{"value"} }
+func (yieldFrom *YieldFrom) fieldPointers() []interface{} {
+	return []interface{}{&yieldFrom.Value}
+}
+
+// Compare is a chain of comparisons, e.g. a < b == c
+type Compare struct {
+	Position
+
+	Left        Expression
+	Operators   []CompareOperator
+	Comparators []Expression
+}
+
+func NewCompare(left Expression, ops []CompareOperator, comparators []Expression) *Compare {
+	return &Compare{
+		Left:        left,
+		Operators:   ops,
+		Comparators: comparators,
+	}
+}
+
+func (compare *Compare) node()          {}
+func (compare *Compare) expr()          {}
+func (compare *Compare) String() string { return Dump(compare, false) }
+func (compare *Compare) dump(dumper *dumper) string {
+	ops := make([]string, 0, len(compare.Operators))
+	for _, op := range compare.Operators {
+		ops = append(ops, dumper.node(op))
+	}
+	return fmt.Sprintf("Compare(left=%s, ops=%s, comparators=%s)", dumper.node(compare.Left), dumpList(ops), dumper.expressions(compare.Comparators))
+}
+func (compare *Compare) Fields() []string { return []string{"left", "ops", "comparators"} }
+func (compare *Compare) fieldPointers() []interface{} {
+	return []interface{}{&compare.Left, &compare.Operators, &compare.Comparators}
+}
+
+type Call struct {
+	Position
+
+	Function Expression
+	Args     []Expression
+	Keywords []*Keyword
+}
+
+func NewCall(function Expression, args []Expression, keywords []*Keyword) *Call {
+	return &Call{
+		Function: function,
+		Args:     args,
+		Keywords: keywords,
+	}
+}
+
+func (call *Call) node()          {}
+func (call *Call) expr()          {}
+func (call *Call) String() string { return Dump(call, false) }
+func (call *Call) dump(dumper *dumper) string {
+	return fmt.Sprintf("Call(func=%s, args=%s, keywords=%s)", dumper.node(call.Function), dumper.expressions(call.Args), dumper.keywords(call.Keywords))
+}
+func (call *Call) Fields() []string { return []string{"func", "args", "keywords"} }
+func (call *Call) fieldPointers() []interface{} {
+	return []interface{}{&call.Function, &call.Args, &call.Keywords}
+}
+
+type Num struct {
+	Position
+
+	// Value is a *gython.Long, *gython.Float or *gython.Complex
+	Value gython.Object
+}
+
+func NewNum(value gython.Object) *Num {
+	return &Num{
+		Value: value,
+	}
+}
+
+func (num *Num) node()          {}
+func (num *Num) expr()          {}
+func (num *Num) String() string { return Dump(num, false) }
+func (num *Num) dump(dumper *dumper) string {
+	return fmt.Sprintf("Num(n=%s)", gython.Repr(num.Value))
+}
+func (num *Num) Fields() []string { return []string{"n"} }
+func (num *Num) fieldPointers() []interface{} {
+	return []interface{}{&num.Value}
+}
+
+type Str struct {
+	Position
+
+	Value *gython.Unicode
+}
+
+func NewStr(value *gython.Unicode) *Str {
+	return &Str{
+		Value: value,
+	}
+}
+
+func (str *Str) node()          {}
+func (str *Str) expr()          {}
+func (str *Str) String() string { return Dump(str, false) }
+func (str *Str) dump(dumper *dumper) string {
+	return fmt.Sprintf("Str(s=%s)", str.Value.Repr())
+}
+func (str *Str) Fields() []string { return []string{"s"} }
+func (str *Str) fieldPointers() []interface{} {
+	return []interface{}{&str.Value}
+}
+
+type Bytes struct {
+	Position
+
+	Value *gython.Bytes
+}
+
+func NewBytes(value *gython.Bytes) *Bytes {
+	return &Bytes{
+		Value: value,
+	}
+}
+
+func (bytes *Bytes) node()          {}
+func (bytes *Bytes) expr()          {}
+func (bytes *Bytes) String() string { return Dump(bytes, false) }
+func (bytes *Bytes) dump(dumper *dumper) string {
+	return fmt.Sprintf("Bytes(s=%s)", bytes.Value.Repr())
+}
+func (bytes *Bytes) Fields() []string { return []string{"s"} }
+func (bytes *Bytes) fieldPointers() []interface{} {
+	return []interface{}{&bytes.Value}
+}
+
+// NameConstant is None, True or False
+type NameConstant struct {
+	Position
+
+	Value gython.Object
+}
+
+func NewNameConstant(value gython.Object) *NameConstant {
+	return &NameConstant{
+		Value: value,
+	}
+}
+
+func (nameConstant *NameConstant) node()          {}
+func (nameConstant *NameConstant) expr()          {}
+func (nameConstant *NameConstant) String() string { return Dump(nameConstant, false) }
+func (nameConstant *NameConstant) dump(dumper *dumper) string {
+	return fmt.Sprintf("NameConstant(value=%s)", gython.Repr(nameConstant.Value))
+}
+func (nameConstant *NameConstant) Fields() []string { return []string{"value"} }
+func (nameConstant *NameConstant) fieldPointers() []interface{} {
+	return []interface{}{&nameConstant.Value}
+}
+
+type Ellipsis struct {
+	Position
+}
+
+func NewEllipsis() *Ellipsis {
+	return &Ellipsis{}
+}
+
+func (ellipsis *Ellipsis) node()          {}
+func (ellipsis *Ellipsis) expr()          {}
+func (ellipsis *Ellipsis) String() string { return "Ellipsis()" }
+
+func (ellipsis *Ellipsis) Fields() []string { return nil }
+
+type Attribute struct {
+	Position
+
+	Value   Expression
+	Attr    *gython.Unicode
+	Context ExpressionContext
+}
+
+func NewAttribute(value Expression, attr string, ctx ExpressionContext) *Attribute {
+	return &Attribute{
+		Value:   value,
+		Attr:    gython.NewUnicode([]byte(attr)),
+		Context: ctx,
+	}
+}
+
+func (attribute *Attribute) node()          {}
+func (attribute *Attribute) expr()          {}
+func (attribute *Attribute) String() string { return Dump(attribute, false) }
+func (attribute *Attribute) dump(dumper *dumper) string {
+	return fmt.Sprintf(
+		"Attribute(value=%s, attr=%s, ctx=%s)",
+		dumper.node(attribute.Value), dumpIdentifier(attribute.Attr), dumper.node(attribute.Context),
+	)
+}
+func (attribute *Attribute) Fields() []string { return []string{"value", "attr", "ctx"} }
+func (attribute *Attribute) fieldPointers() []interface{} {
+	return []interface{}{&attribute.Value, &attribute.Attr, &attribute.Context}
+}
+
+type Subscript struct {
+	Position
+
+	Value   Expression
+	Slice   SliceNode
+	Context ExpressionContext
+}
+
+func NewSubscript(value Expression, slice SliceNode, ctx ExpressionContext) *Subscript {
+	return &Subscript{
+		Value:   value,
+		Slice:   slice,
+		Context: ctx,
+	}
+}
+
+func (subscript *Subscript) node()          {}
+func (subscript *Subscript) expr()          {}
+func (subscript *Subscript) String() string { return Dump(subscript, false) }
+func (subscript *Subscript) dump(dumper *dumper) string {
+	return fmt.Sprintf(
+		"Subscript(value=%s, slice=%s, ctx=%s)",
+		dumper.node(subscript.Value), dumper.node(subscript.Slice), dumper.node(subscript.Context),
+	)
+}
+func (subscript *Subscript) Fields() []string { return []string{"value", "slice", "ctx"} }
+func (subscript *Subscript) fieldPointers() []interface{} {
+	return []interface{}{&subscript.Value, &subscript.Slice, &subscript.Context}
+}
+
+type Starred struct {
+	Position
+
+	Value   Expression
+	Context ExpressionContext
+}
+
+func NewStarred(value Expression, ctx ExpressionContext) *Starred {
+	return &Starred{
+		Value:   value,
+		Context: ctx,
+	}
+}
+
+func (starred *Starred) node()          {}
+func (starred *Starred) expr()          {}
+func (starred *Starred) String() string { return Dump(starred, false) }
+func (starred *Starred) dump(dumper *dumper) string {
+	return fmt.Sprintf("Starred(value=%s, ctx=%s)", dumper.node(starred.Value), dumper.node(starred.Context))
+}
+func (starred *Starred) Fields() []string { return []string{"value", "ctx"} }
+func (starred *Starred) fieldPointers() []interface{} {
+	return []interface{}{&starred.Value, &starred.Context}
 }
 
 type Name struct {
+	Position
+
 	Identifier *gython.Unicode
 	Context    ExpressionContext
 }
@@ -23,24 +633,100 @@ func NewName(id string, ctx ExpressionContext) *Name {
 	}
 }
 
-func (name *Name) node() {}
-func (name *Name) expr() {}
-func (name *Name) String() string {
-	return fmt.Sprintf("Name(id=%#v, ctx=%s)", name.Identifier, name.Context.String())
+func (name *Name) node()          {}
+func (name *Name) expr()          {}
+func (name *Name) String() string { return Dump(name, false) }
+func (name *Name) dump(dumper *dumper) string {
+	return fmt.Sprintf("Name(id=%s, ctx=%s)", dumpIdentifier(name.Identifier), dumper.node(name.Context))
+}
+func (name *Name) Fields() []string { return []string{"id", "ctx"} }
+func (name *Name) fieldPointers() []interface{} {
+	return []interface{}{&name.Identifier, &name.Context}
 }
 
-type Num struct {
-	Value *gython.Float
+type List struct {
+	Position
+
+	Elements []Expression
+	Context  ExpressionContext
 }
 
-func NewNum(i int64) *Num {
-	return &Num{
-		Value: gython.NewFloat(float64(i)),
+func NewList(elements []Expression, ctx ExpressionContext) *List {
+	return &List{
+		Elements: elements,
+		Context:  ctx,
+	}
+}
+
+func (list *List) node()          {}
+func (list *List) expr()          {}
+func (list *List) String() string { return Dump(list, false) }
+func (list *List) dump(dumper *dumper) string {
+	return fmt.Sprintf("List(elts=%s, ctx=%s)", dumper.expressions(list.Elements), dumper.node(list.Context))
+}
+func (list *List) Fields() []string { return []string{"elts", "ctx"} }
+func (list *List) fieldPointers() []interface{} {
+	return []interface{}{&list.Elements, &list.Context}
+}
+
+type Tuple struct {
+	Position
+
+	Elements []Expression
+	Context  ExpressionContext
+}
+
+func NewTuple(elements []Expression, ctx ExpressionContext) *Tuple {
+	return &Tuple{
+		Elements: elements,
+		Context:  ctx,
 	}
 }
 
-func (num *Num) node() {}
-func (num *Num) expr() {}
-func (num *Num) String() string {
-	return fmt.Sprintf("Num(n=%d)", num.Value)
+func (tuple *Tuple) node()          {}
+func (tuple *Tuple) expr()          {}
+func (tuple *Tuple) String() string { return Dump(tuple, false) }
+func (tuple *Tuple) dump(dumper *dumper) string {
+	return fmt.Sprintf("Tuple(elts=%s, ctx=%s)", dumper.expressions(tuple.Elements), dumper.node(tuple.Context))
+}
+func (tuple *Tuple) Fields() []string { return []string{"elts", "ctx"} }
+func (tuple *Tuple) fieldPointers() []interface{} {
+	return []interface{}{&tuple.Elements, &tuple.Context}
+}
+
+// Comprehension is one "for" clause of a comprehension, along with its "if" clauses
+type Comprehension struct {
+	Target Expression
+	Iter   Expression
+	Ifs    []Expression
+}
+
+func NewComprehension(target Expression, iter Expression, ifs []Expression) *Comprehension {
+	return &Comprehension{
+		Target: target,
+		Iter:   iter,
+		Ifs:    ifs,
+	}
+}
+
+func (comprehension *Comprehension) node()          {}
+func (comprehension *Comprehension) String() string { return Dump(comprehension, false) }
+func (comprehension *Comprehension) dump(dumper *dumper) string {
+	return fmt.Sprintf(
+		"comprehension(target=%s, iter=%s, ifs=%s)",
+		dumper.node(comprehension.Target), dumper.node(comprehension.Iter), dumper.expressions(comprehension.Ifs),
+	)
+}
+func (comprehension *Comprehension) Fields() []string     { return []string{"target", "iter", "ifs"} }
+func (comprehension *Comprehension) Attributes() []string { return nil }
+func (comprehension *Comprehension) fieldPointers() []interface{} {
+	return []interface{}{&comprehension.Target, &comprehension.Iter, &comprehension.Ifs}
+}
+
+func (dumper *dumper) comprehensions(comprehensions []*Comprehension) string {
+	items := make([]string, 0, len(comprehensions))
+	for _, comprehension := range comprehensions {
+		items = append(items, dumper.node(comprehension))
+	}
+	return dumpList(items)
 }
@@ -15,6 +15,9 @@ func (store *Store) node()          {}
 func (store *Store) exprCtx()       {}
 func (store *Store) String() string { return "Store()" }
 
+func (store *Store) Fields() []string     { return nil }
+func (store *Store) Attributes() []string { return nil }
+
 type Load struct{}
 
 func NewLoad() *Load {
@@ -24,3 +27,58 @@ func NewLoad() *Load {
 func (load *Load) node()          {}
 func (load *Load) exprCtx()       {}
 func (load *Load) String() string { return "Load()" }
+
+func (load *Load) Fields() []string     { return nil }
+func (load *Load) Attributes() []string { return nil }
+
+type Del struct{}
+
+func NewDel() *Del {
+	return &Del{}
+}
+
+func (del *Del) node()          {}
+func (del *Del) exprCtx()       {}
+func (del *Del) String() string { return "Del()" }
+
+func (del *Del) Fields() []string     { return nil }
+func (del *Del) Attributes() []string { return nil }
+
+type AugLoad struct{}
+
+func NewAugLoad() *AugLoad {
+	return &AugLoad{}
+}
+
+func (augLoad *AugLoad) node()          {}
+func (augLoad *AugLoad) exprCtx()       {}
+func (augLoad *AugLoad) String() string { return "AugLoad()" }
+
+func (augLoad *AugLoad) Fields() []string     { return nil }
+func (augLoad *AugLoad) Attributes() []string { return nil }
+
+type AugStore struct{}
+
+func NewAugStore() *AugStore {
+	return &AugStore{}
+}
+
+func (augStore *AugStore) node()          {}
+func (augStore *AugStore) exprCtx()       {}
+func (augStore *AugStore) String() string { return "AugStore()" }
+
+func (augStore *AugStore) Fields() []string     { return nil }
+func (augStore *AugStore) Attributes() []string { return nil }
+
+type Param struct{}
+
+func NewParam() *Param {
+	return &Param{}
+}
+
+func (param *Param) node()          {}
+func (param *Param) exprCtx()       {}
+func (param *Param) String() string { return "Param()" }
+
+func (param *Param) Fields() []string     { return nil }
+func (param *Param) Attributes() []string { return nil }
@@ -0,0 +1,659 @@
+package ast
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/brettlangdon/gython/gython"
+)
+
+// Constructors of every node by its Python type name
+var nodeTypes = map[string]func() Node{
+	"Module": func() Node { return &Module{} },
+
+	"FunctionDef":      func() Node { return &FunctionDef{} },
+	"AsyncFunctionDef": func() Node { return &AsyncFunctionDef{} },
+	"ClassDef":         func() Node { return &ClassDef{} },
+	"Return":           func() Node { return &Return{} },
+	"Delete":           func() Node { return &Delete{} },
+	"Assign":           func() Node { return &Assign{} },
+	"AugAssign":        func() Node { return &AugAssign{} },
+	"For":              func() Node { return &For{} },
+	"AsyncFor":         func() Node { return &AsyncFor{} },
+	"While":            func() Node { return &While{} },
+	"If":               func() Node { return &If{} },
+	"With":             func() Node { return &With{} },
+	"AsyncWith":        func() Node { return &AsyncWith{} },
+	"Raise":            func() Node { return &Raise{} },
+	"Try":              func() Node { return &Try{} },
+	"Assert":           func() Node { return &Assert{} },
+	"Import":           func() Node { return &Import{} },
+	"ImportFrom":       func() Node { return &ImportFrom{} },
+	"Global":           func() Node { return &Global{} },
+	"Nonlocal":         func() Node { return &Nonlocal{} },
+	"Expr":             func() Node { return &Expr{} },
+	"Pass":             func() Node { return &Pass{} },
+	"Break":            func() Node { return &Break{} },
+	"Continue":         func() Node { return &Continue{} },
+
+	"BoolOp":       func() Node { return &BoolOp{} },
+	"BinOp":        func() Node { return &BinOp{} },
+	"UnaryOp":      func() Node { return &UnaryOp{} },
+	"Lambda":       func() Node { return &Lambda{} },
+	"IfExp":        func() Node { return &IfExp{} },
+	"Dict":         func() Node { return &Dict{} },
+	"Set":          func() Node { return &Set{} },
+	"ListComp":     func() Node { return &ListComp{} },
+	"SetComp":      func() Node { return &SetComp{} },
+	"DictComp":     func() Node { return &DictComp{} },
+	"GeneratorExp": func() Node { return &GeneratorExp{} },
+	"Await":        func() Node { return &Await{} },
+	"Yield":        func() Node { return &Yield{} },
+	"YieldFrom":    func() Node { return &YieldFrom{} },
+	"Compare":      func() Node { return &Compare{} },
+	"Call":         func() Node { return &Call{} },
+	"Num":          func() Node { return &Num{} },
+	"Str":          func() Node { return &Str{} },
+	"Bytes":        func() Node { return &Bytes{} },
+	"NameConstant": func() Node { return &NameConstant{} },
+	"Ellipsis":     func() Node { return &Ellipsis{} },
+	"Attribute":    func() Node { return &Attribute{} },
+	"Subscript":    func() Node { return &Subscript{} },
+	"Starred":      func() Node { return &Starred{} },
+	"Name":         func() Node { return &Name{} },
+	"List":         func() Node { return &List{} },
+	"Tuple":        func() Node { return &Tuple{} },
+
+	"Load":     func() Node { return NewLoad() },
+	"Store":    func() Node { return NewStore() },
+	"Del":      func() Node { return NewDel() },
+	"AugLoad":  func() Node { return NewAugLoad() },
+	"AugStore": func() Node { return NewAugStore() },
+	"Param":    func() Node { return NewParam() },
+
+	"Slice":    func() Node { return &Slice{} },
+	"ExtSlice": func() Node { return &ExtSlice{} },
+	"Index":    func() Node { return &Index{} },
+
+	"And": func() Node { return NewAnd() },
+	"Or":  func() Node { return NewOr() },
+
+	"Add":      func() Node { return NewAdd() },
+	"Sub":      func() Node { return NewSub() },
+	"Mult":     func() Node { return NewMult() },
+	"MatMult":  func() Node { return NewMatMult() },
+	"Div":      func() Node { return NewDiv() },
+	"Mod":      func() Node { return NewModulo() },
+	"Pow":      func() Node { return NewPow() },
+	"LShift":   func() Node { return NewLShift() },
+	"RShift":   func() Node { return NewRShift() },
+	"BitOr":    func() Node { return NewBitOr() },
+	"BitXor":   func() Node { return NewBitXor() },
+	"BitAnd":   func() Node { return NewBitAnd() },
+	"FloorDiv": func() Node { return NewFloorDiv() },
+
+	"Invert": func() Node { return NewInvert() },
+	"Not":    func() Node { return NewNot() },
+	"UAdd":   func() Node { return NewUAdd() },
+	"USub":   func() Node { return NewUSub() },
+
+	"Eq":    func() Node { return NewEq() },
+	"NotEq": func() Node { return NewNotEq() },
+	"Lt":    func() Node { return NewLt() },
+	"LtE":   func() Node { return NewLtE() },
+	"Gt":    func() Node { return NewGt() },
+	"GtE":   func() Node { return NewGtE() },
+	"Is":    func() Node { return NewIs() },
+	"IsNot": func() Node { return NewIsNot() },
+	"In":    func() Node { return NewIn() },
+	"NotIn": func() Node { return NewNotIn() },
+
+	"comprehension": func() Node { return &Comprehension{} },
+	"ExceptHandler": func() Node { return &ExceptHandler{} },
+	"arguments":     func() Node { return &Arguments{} },
+	"arg":           func() Node { return &Arg{} },
+	"keyword":       func() Node { return &Keyword{} },
+	"alias":         func() Node { return &Alias{} },
+	"withitem":      func() Node { return &WithItem{} },
+}
+
+var (
+	typeNamesOnce sync.Once
+	typeNames     map[string]string
+)
+
+// typeName returns the Python type name of node
+func typeName(node Node) string {
+	typeNamesOnce.Do(func() {
+		typeNames = make(map[string]string, len(nodeTypes))
+		for name, newNode := range nodeTypes {
+			typeNames[fmt.Sprintf("%T", newNode())] = name
+		}
+	})
+	return typeNames[fmt.Sprintf("%T", node)]
+}
+
+// EncodeJSON returns the JSON encoding of the tree rooted at node. Every node is an object
+// naming its Python type in "_type", followed by its fields and its lineno and col_offset.
+// Lists are arrays and missing optional values are null. Identifiers and str values are
+// strings, bytes values are base64 strings and NameConstant values are true, false or null.
+// A number is an int or float JSON number, where the float always has a point or exponent,
+// "inf", "-inf" or "nan" for a float without a JSON number, or {"real": x, "imag": y}.
+func EncodeJSON(node Node) ([]byte, error) {
+	encoder := &jsonEncoder{}
+	if err := encoder.node(node); err != nil {
+		return nil, err
+	}
+	return encoder.buffer.Bytes(), nil
+}
+
+// jsonEncoder writes the JSON encoding of a tree
+type jsonEncoder struct {
+	buffer bytes.Buffer
+}
+
+func (encoder *jsonEncoder) node(node Node) error {
+	if node == nil || isNilNode(node) {
+		encoder.buffer.WriteString("null")
+		return nil
+	}
+	name := typeName(node)
+	if name == "" {
+		return fmt.Errorf("ast: cannot encode %T as JSON", node)
+	}
+
+	encoder.buffer.WriteString(`{"_type":`)
+	encoder.buffer.Write(quoteJSON([]byte(name)))
+	for _, field := range IterFields(node) {
+		encoder.buffer.WriteString(",")
+		encoder.buffer.Write(quoteJSON([]byte(field.Name)))
+		encoder.buffer.WriteString(":")
+		if err := encoder.value(field.Value); err != nil {
+			return err
+		}
+	}
+	if positioned, isPositioned := node.(Positioned); isPositioned {
+		pos := positioned.Pos()
+		fmt.Fprintf(&encoder.buffer, `,"lineno":%d,"col_offset":%d`, pos.Lineno, pos.ColOffset)
+	}
+	encoder.buffer.WriteString("}")
+	return nil
+}
+
+// value writes the value of a field, as returned by IterFields
+func (encoder *jsonEncoder) value(value interface{}) error {
+	switch value := value.(type) {
+	case nil:
+		encoder.buffer.WriteString("null")
+	case Node:
+		return encoder.node(value)
+	case []Expression:
+		return encoder.list(len(value), func(i int) error { return encoder.node(value[i]) })
+	case []Statement:
+		return encoder.list(len(value), func(i int) error { return encoder.node(value[i]) })
+	case []CompareOperator:
+		return encoder.list(len(value), func(i int) error { return encoder.node(value[i]) })
+	case []SliceNode:
+		return encoder.list(len(value), func(i int) error { return encoder.node(value[i]) })
+	case []*Arg:
+		return encoder.list(len(value), func(i int) error { return encoder.node(value[i]) })
+	case []*Keyword:
+		return encoder.list(len(value), func(i int) error { return encoder.node(value[i]) })
+	case []*Alias:
+		return encoder.list(len(value), func(i int) error { return encoder.node(value[i]) })
+	case []*WithItem:
+		return encoder.list(len(value), func(i int) error { return encoder.node(value[i]) })
+	case []*Comprehension:
+		return encoder.list(len(value), func(i int) error { return encoder.node(value[i]) })
+	case []*ExceptHandler:
+		return encoder.list(len(value), func(i int) error { return encoder.node(value[i]) })
+	case *gython.Unicode:
+		if value == nil {
+			encoder.buffer.WriteString("null")
+		} else {
+			encoder.buffer.Write(quoteJSON(value.Value))
+		}
+	case []*gython.Unicode:
+		return encoder.list(len(value), func(i int) error { return encoder.value(value[i]) })
+	case *gython.Bytes:
+		if value == nil {
+			encoder.buffer.WriteString("null")
+		} else {
+			encoder.buffer.WriteString(`"` + base64.StdEncoding.EncodeToString(value.Value()) + `"`)
+		}
+	case int:
+		encoder.buffer.WriteString(strconv.Itoa(value))
+	case gython.Object:
+		return encoder.object(value)
+	default:
+		return fmt.Errorf("ast: cannot encode %T as JSON", value)
+	}
+	return nil
+}
+
+func (encoder *jsonEncoder) list(length int, item func(i int) error) error {
+	encoder.buffer.WriteString("[")
+	for i := 0; i < length; i++ {
+		if i > 0 {
+			encoder.buffer.WriteString(",")
+		}
+		if err := item(i); err != nil {
+			return err
+		}
+	}
+	encoder.buffer.WriteString("]")
+	return nil
+}
+
+// object writes the value of a Num or NameConstant
+func (encoder *jsonEncoder) object(object gython.Object) error {
+	switch object := object.(type) {
+	case *gython.Bool:
+		encoder.buffer.WriteString(strconv.FormatBool(object.Value))
+	case *gython.Long:
+		encoder.buffer.WriteString(object.Value.String())
+	case *gython.Float:
+		encoder.buffer.WriteString(jsonFloat(object.Value))
+	case *gython.Complex:
+		fmt.Fprintf(&encoder.buffer, `{"real":%s,"imag":%s}`, jsonFloat(object.Real), jsonFloat(object.Imag))
+	default:
+		if object == gython.None {
+			encoder.buffer.WriteString("null")
+			return nil
+		}
+		return fmt.Errorf("ast: cannot encode %T as JSON", object)
+	}
+	return nil
+}
+
+// jsonFloat returns the repr of f, quoted when it is not a JSON number
+func jsonFloat(f float64) string {
+	repr := gython.NewFloat(f).Repr()
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return `"` + repr + `"`
+	}
+	return repr
+}
+
+// quoteJSON returns value as a JSON string. A lone surrogate, which a str value keeps in its
+// three byte form, becomes a \u escape so it is not lost. Every other character is written as
+// itself, so a surrogate escape only ever stands for a lone surrogate, even next to another one.
+func quoteJSON(value []byte) []byte {
+	quoted := make([]byte, 0, len(value)+2)
+	quoted = append(quoted, '"')
+	for i := 0; i < len(value); {
+		c := value[i]
+		switch {
+		case c == '"' || c == '\\':
+			quoted = append(quoted, '\\', c)
+			i++
+		case c == '\n':
+			quoted = append(quoted, `\n`...)
+			i++
+		case c == '\r':
+			quoted = append(quoted, `\r`...)
+			i++
+		case c == '\t':
+			quoted = append(quoted, `\t`...)
+			i++
+		case c < ' ':
+			quoted = append(quoted, fmt.Sprintf(`\u%04x`, c)...)
+			i++
+		case c < utf8.RuneSelf:
+			quoted = append(quoted, c)
+			i++
+		case c == 0xed && i+2 < len(value) && value[i+1] >= 0xa0 && value[i+1] <= 0xbf:
+			code := rune(c&0x0f)<<12 | rune(value[i+1]&0x3f)<<6 | rune(value[i+2]&0x3f)
+			quoted = append(quoted, fmt.Sprintf(`\u%04x`, code)...)
+			i += 3
+		default:
+			r, size := utf8.DecodeRune(value[i:])
+			quoted = utf8.AppendRune(quoted, r)
+			i += size
+		}
+	}
+	return append(quoted, '"')
+}
+
+// DecodeJSON rebuilds a tree from the encoding of EncodeJSON
+func DecodeJSON(data []byte) (Mod, error) {
+	node, err := decodeJSONNode(json.RawMessage(data), "")
+	if err != nil {
+		return nil, err
+	}
+	mod, isMod := node.(Mod)
+	if !isMod {
+		return nil, fmt.Errorf("ast: expected a Module, found %s", typeName(node))
+	}
+	return mod, nil
+}
+
+// decodeJSONNode decodes an optional node, path locates it in the encoding for errors
+func decodeJSONNode(data json.RawMessage, path string) (Node, error) {
+	if isJSONNull(data) {
+		return nil, nil
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, jsonDecodeError(path, "expected a node object")
+	}
+	var name string
+	if err := json.Unmarshal(object["_type"], &name); err != nil {
+		return nil, jsonDecodeError(path, "missing the _type of a node")
+	}
+	newNode, known := nodeTypes[name]
+	if !known {
+		return nil, jsonDecodeError(path, fmt.Sprintf("unknown node type %q", name))
+	}
+	if path == "" {
+		path = name
+	}
+
+	node := newNode()
+	if fielded, ok := node.(withFields); ok {
+		for i, pointer := range fielded.fieldPointers() {
+			fieldPath := path + "." + node.Fields()[i]
+			if err := decodeJSONField(object[node.Fields()[i]], pointer, fieldPath); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if positioned, isPositioned := node.(Positioned); isPositioned {
+		pos := positioned.Pos()
+		for _, attribute := range []struct {
+			name  string
+			value *int
+		}{{"lineno", &pos.Lineno}, {"col_offset", &pos.ColOffset}} {
+			if err := decodeJSONInt(object[attribute.name], attribute.value, path+"."+attribute.name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return node, nil
+}
+
+// decodeJSONField decodes a field into the pointer returned by fieldPointers, a missing list is empty
+func decodeJSONField(data json.RawMessage, pointer interface{}, path string) error {
+	var items []json.RawMessage
+	switch pointer.(type) {
+	case *[]Expression, *[]Statement, *[]CompareOperator, *[]SliceNode, *[]*Arg, *[]*Keyword,
+		*[]*Alias, *[]*WithItem, *[]*Comprehension, *[]*ExceptHandler, *[]*gython.Unicode:
+		if !isJSONNull(data) {
+			if err := json.Unmarshal(data, &items); err != nil {
+				return jsonDecodeError(path, "expected a list")
+			}
+		}
+	}
+	// node decodes the node at path, checking it is one fits holds
+	node := func(data json.RawMessage, path string, fits func(Node) bool) (Node, error) {
+		node, err := decodeJSONNode(data, path)
+		if err == nil && node != nil && !fits(node) {
+			err = jsonDecodeError(path, fmt.Sprintf("unexpected %s node", typeName(node)))
+		}
+		return node, err
+	}
+	itemPath := func(i int) string { return fmt.Sprintf("%s[%d]", path, i) }
+
+	var err error
+	switch pointer := pointer.(type) {
+	case *Expression:
+		var value Node
+		value, err = node(data, path, func(n Node) bool { _, ok := n.(Expression); return ok })
+		*pointer, _ = value.(Expression)
+	case *[]Expression:
+		*pointer = make([]Expression, len(items))
+		for i := 0; i < len(items) && err == nil; i++ {
+			var value Node
+			value, err = node(items[i], itemPath(i), func(n Node) bool { _, ok := n.(Expression); return ok })
+			(*pointer)[i], _ = value.(Expression)
+		}
+	case *[]Statement:
+		*pointer = make([]Statement, len(items))
+		for i := 0; i < len(items) && err == nil; i++ {
+			var value Node
+			value, err = node(items[i], itemPath(i), func(n Node) bool { _, ok := n.(Statement); return ok })
+			(*pointer)[i], _ = value.(Statement)
+		}
+	case *ExpressionContext:
+		var value Node
+		value, err = node(data, path, func(n Node) bool { _, ok := n.(ExpressionContext); return ok })
+		*pointer, _ = value.(ExpressionContext)
+	case *Operator:
+		var value Node
+		value, err = node(data, path, func(n Node) bool { _, ok := n.(Operator); return ok })
+		*pointer, _ = value.(Operator)
+	case *UnaryOperator:
+		var value Node
+		value, err = node(data, path, func(n Node) bool { _, ok := n.(UnaryOperator); return ok })
+		*pointer, _ = value.(UnaryOperator)
+	case *BooleanOperator:
+		var value Node
+		value, err = node(data, path, func(n Node) bool { _, ok := n.(BooleanOperator); return ok })
+		*pointer, _ = value.(BooleanOperator)
+	case *[]CompareOperator:
+		*pointer = make([]CompareOperator, len(items))
+		for i := 0; i < len(items) && err == nil; i++ {
+			var value Node
+			value, err = node(items[i], itemPath(i), func(n Node) bool { _, ok := n.(CompareOperator); return ok })
+			(*pointer)[i], _ = value.(CompareOperator)
+		}
+	case *SliceNode:
+		var value Node
+		value, err = node(data, path, func(n Node) bool { _, ok := n.(SliceNode); return ok })
+		*pointer, _ = value.(SliceNode)
+	case *[]SliceNode:
+		*pointer = make([]SliceNode, len(items))
+		for i := 0; i < len(items) && err == nil; i++ {
+			var value Node
+			value, err = node(items[i], itemPath(i), func(n Node) bool { _, ok := n.(SliceNode); return ok })
+			(*pointer)[i], _ = value.(SliceNode)
+		}
+	case **Arguments:
+		var value Node
+		value, err = node(data, path, func(n Node) bool { _, ok := n.(*Arguments); return ok })
+		*pointer, _ = value.(*Arguments)
+	case **Arg:
+		var value Node
+		value, err = node(data, path, func(n Node) bool { _, ok := n.(*Arg); return ok })
+		*pointer, _ = value.(*Arg)
+	case *[]*Arg:
+		*pointer = make([]*Arg, len(items))
+		for i := 0; i < len(items) && err == nil; i++ {
+			var value Node
+			value, err = node(items[i], itemPath(i), func(n Node) bool { _, ok := n.(*Arg); return ok })
+			(*pointer)[i], _ = value.(*Arg)
+		}
+	case *[]*Keyword:
+		*pointer = make([]*Keyword, len(items))
+		for i := 0; i < len(items) && err == nil; i++ {
+			var value Node
+			value, err = node(items[i], itemPath(i), func(n Node) bool { _, ok := n.(*Keyword); return ok })
+			(*pointer)[i], _ = value.(*Keyword)
+		}
+	case *[]*Alias:
+		*pointer = make([]*Alias, len(items))
+		for i := 0; i < len(items) && err == nil; i++ {
+			var value Node
+			value, err = node(items[i], itemPath(i), func(n Node) bool { _, ok := n.(*Alias); return ok })
+			(*pointer)[i], _ = value.(*Alias)
+		}
+	case *[]*WithItem:
+		*pointer = make([]*WithItem, len(items))
+		for i := 0; i < len(items) && err == nil; i++ {
+			var value Node
+			value, err = node(items[i], itemPath(i), func(n Node) bool { _, ok := n.(*WithItem); return ok })
+			(*pointer)[i], _ = value.(*WithItem)
+		}
+	case *[]*Comprehension:
+		*pointer = make([]*Comprehension, len(items))
+		for i := 0; i < len(items) && err == nil; i++ {
+			var value Node
+			value, err = node(items[i], itemPath(i), func(n Node) bool { _, ok := n.(*Comprehension); return ok })
+			(*pointer)[i], _ = value.(*Comprehension)
+		}
+	case *[]*ExceptHandler:
+		*pointer = make([]*ExceptHandler, len(items))
+		for i := 0; i < len(items) && err == nil; i++ {
+			var value Node
+			value, err = node(items[i], itemPath(i), func(n Node) bool { _, ok := n.(*ExceptHandler); return ok })
+			(*pointer)[i], _ = value.(*ExceptHandler)
+		}
+	case **gython.Unicode:
+		*pointer, err = decodeJSONIdentifier(data, path)
+	case *[]*gython.Unicode:
+		*pointer = make([]*gython.Unicode, len(items))
+		for i := 0; i < len(items) && err == nil; i++ {
+			(*pointer)[i], err = decodeJSONIdentifier(items[i], itemPath(i))
+		}
+	case **gython.Bytes:
+		var encoded string
+		if err = json.Unmarshal(data, &encoded); err != nil {
+			return jsonDecodeError(path, "expected a base64 string")
+		}
+		var value []byte
+		if value, err = base64.StdEncoding.DecodeString(encoded); err != nil {
+			return jsonDecodeError(path, "expected a base64 string")
+		}
+		*pointer = gython.NewBytesFrom(value)
+	case *gython.Object:
+		*pointer, err = decodeJSONObject(data, path)
+	case *int:
+		err = decodeJSONInt(data, pointer, path)
+	}
+	return err
+}
+
+// decodeJSONIdentifier decodes an optional identifier or str value
+func decodeJSONIdentifier(data json.RawMessage, path string) (*gython.Unicode, error) {
+	if isJSONNull(data) {
+		return nil, nil
+	}
+	value, ok := unquoteJSON(data)
+	if !ok {
+		return nil, jsonDecodeError(path, "expected a string")
+	}
+	return gython.NewUnicode(value), nil
+}
+
+func decodeJSONInt(data json.RawMessage, value *int, path string) error {
+	if data == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return jsonDecodeError(path, "expected an integer")
+	}
+	return nil
+}
+
+// decodeJSONObject decodes the value of a Num or NameConstant
+func decodeJSONObject(data json.RawMessage, path string) (gython.Object, error) {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, jsonDecodeError(path, "expected a constant")
+	}
+
+	switch value := value.(type) {
+	case nil:
+		return gython.None, nil
+	case bool:
+		if value {
+			return gython.True, nil
+		}
+		return gython.False, nil
+	case json.Number:
+		if !strings.ContainsAny(string(value), ".eE") {
+			integer, _ := new(big.Int).SetString(string(value), 10)
+			return gython.NewLong(integer), nil
+		}
+		float, err := value.Float64()
+		if err != nil && err.(*strconv.NumError).Err != strconv.ErrRange {
+			return nil, jsonDecodeError(path, "expected a number")
+		}
+		return gython.NewFloat(float), nil
+	case string:
+		if float, ok := decodeJSONFloat(value); ok {
+			return gython.NewFloat(float), nil
+		}
+	case map[string]interface{}:
+		real, realOK := decodeJSONFloat(value["real"])
+		imag, imagOK := decodeJSONFloat(value["imag"])
+		if realOK && imagOK && len(value) == 2 {
+			return gython.NewComplex(real, imag), nil
+		}
+	}
+	return nil, jsonDecodeError(path, "expected a number, true, false or null")
+}
+
+// decodeJSONFloat decodes a float encoded by jsonFloat
+func decodeJSONFloat(value interface{}) (float64, bool) {
+	switch value := value.(type) {
+	case json.Number:
+		float, err := strconv.ParseFloat(string(value), 64)
+		return float, err == nil || err.(*strconv.NumError).Err == strconv.ErrRange
+	case string:
+		switch value {
+		case "inf":
+			return math.Inf(1), true
+		case "-inf":
+			return math.Inf(-1), true
+		case "nan":
+			return math.NaN(), true
+		}
+	}
+	return 0, false
+}
+
+// unquoteJSON decodes a JSON string, keeping surrogates in their three byte form like the parser does
+func unquoteJSON(data json.RawMessage) ([]byte, bool) {
+	var check string
+	if json.Unmarshal(data, &check) != nil {
+		return nil, false
+	}
+	s := bytes.TrimSpace(data)
+	s = s[1 : len(s)-1]
+
+	value := make([]byte, 0, len(s))
+	for i := 0; i < len(s); {
+		if s[i] != '\\' {
+			value = append(value, s[i])
+			i++
+			continue
+		}
+		c := s[i+1]
+		i += 2
+		if c != 'u' {
+			escaped := map[byte]byte{'b': '\b', 'f': '\f', 'n': '\n', 'r': '\r', 't': '\t'}[c]
+			if escaped == 0 {
+				escaped = c
+			}
+			value = append(value, escaped)
+			continue
+		}
+		// A surrogate escape is a lone surrogate, a pair of them is not combined into one character
+		code, _ := strconv.ParseUint(string(s[i:i+4]), 16, 16)
+		i += 4
+		value = appendCodePoint(value, rune(code))
+	}
+	return value, true
+}
+
+func isJSONNull(data json.RawMessage) bool {
+	return data == nil || string(bytes.TrimSpace(data)) == "null"
+}
+
+func jsonDecodeError(path string, message string) error {
+	if path == "" {
+		return fmt.Errorf("ast: %s", message)
+	}
+	return fmt.Errorf("ast: %s: %s", path, message)
+}
@@ -0,0 +1,52 @@
+package ast
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	sources := append([]string{
+		"x = 'caf\\xe9 \\ud800', b'\\x00\\xff', 1e400, -2j, 10 ** 30, None\n",
+		// Two lone surrogates next to each other stay apart from the character they would pair into
+		"x = '\\ud834\\udd20', '\\U0001d120'\n",
+	}, unparseTests...)
+	for _, source := range sources {
+		mod := parseSource(t, source)
+		data, err := EncodeJSON(mod)
+		if err != nil {
+			t.Fatalf("EncodeJSON(%q): %s", source, err)
+		}
+		if !json.Valid(data) {
+			t.Fatalf("EncodeJSON(%q) is not valid JSON: %s", source, data)
+		}
+		decoded, err := DecodeJSON(data)
+		if err != nil {
+			t.Fatalf("DecodeJSON(%s): %s", data, err)
+		}
+		if want, got := Dump(mod, true), Dump(decoded, true); want != got {
+			t.Errorf("%q decoded from JSON\nwant %s\ngot  %s", source, want, got)
+		}
+	}
+}
+
+func TestDecodeJSONErrors(t *testing.T) {
+	tests := []struct {
+		data string
+		err  string
+	}{
+		{`[]`, "ast: expected a node object"},
+		{`{"_type": "Expr"}`, "ast: expected a Module, found Expr"},
+		{`{"_type": "Module", "body": [{"_type": "Spam"}]}`, `ast: Module.body[0]: unknown node type "Spam"`},
+		{`{"_type": "Module", "body": [{"_type": "Expr", "value": {"_type": "Pass"}}]}`, "ast: Module.body[0].value: unexpected Pass node"},
+		{`{"_type": "Module", "body": [{"_type": "Global", "names": [1]}]}`, "ast: Module.body[0].names[0]: expected a string"},
+		{`{"_type": "Module", "body": [{"_type": "Expr", "value": {"_type": "Num", "n": "1"}}]}`, "ast: Module.body[0].value.n: expected a number, true, false or null"},
+	}
+	for _, test := range tests {
+		_, err := DecodeJSON([]byte(test.data))
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("DecodeJSON(%s) returned %v, want %q", test.data, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,363 @@
+package ast
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/brettlangdon/gython/grammar"
+	"github.com/brettlangdon/gython/gython"
+	"github.com/brettlangdon/gython/scanner"
+)
+
+// LiteralTuple is the value of a tuple literal
+type LiteralTuple []interface{}
+
+// LiteralSet is the value of a set literal, its distinct elements in the order they first appear
+type LiteralSet struct {
+	Elements []interface{}
+	index    map[string]bool
+}
+
+// Contains reports whether the set has an element equal to value, as Python compares them
+func (set *LiteralSet) Contains(value interface{}) bool {
+	key, err := literalKey(value)
+	return err == nil && set.index[key]
+}
+
+func (set *LiteralSet) add(value interface{}) error {
+	key, err := literalKey(value)
+	if err != nil {
+		return err
+	}
+	// NaN is not equal to itself, so every NaN is a new element
+	if !set.index[key] || key == nanKey {
+		set.index[key] = true
+		set.Elements = append(set.Elements, value)
+	}
+	return nil
+}
+
+// LiteralDict is the value of a dict literal, its distinct keys in the order they first appear
+type LiteralDict struct {
+	Keys   []interface{}
+	Values []interface{}
+	index  map[string]int
+}
+
+// Get returns the value of the key equal to key, as Python compares them
+func (dict *LiteralDict) Get(key interface{}) (interface{}, bool) {
+	hashed, err := literalKey(key)
+	if err != nil || hashed == nanKey {
+		return nil, false
+	}
+	i, ok := dict.index[hashed]
+	if !ok {
+		return nil, false
+	}
+	return dict.Values[i], true
+}
+
+// set adds an item, like a dict display the first equal key stays with the last value
+func (dict *LiteralDict) set(key interface{}, value interface{}) error {
+	hashed, err := literalKey(key)
+	if err != nil {
+		return err
+	}
+	if i, ok := dict.index[hashed]; ok && hashed != nanKey {
+		dict.Values[i] = value
+		return nil
+	}
+	dict.index[hashed] = len(dict.Keys)
+	dict.Keys = append(dict.Keys, key)
+	dict.Values = append(dict.Values, value)
+	return nil
+}
+
+const nanKey = "float:nan"
+
+// literalKey returns a string which is the same for values Python considers equal, as
+// they hash the same, e.g. 1, 1.0, 1+0j and True. Lists, sets and dicts are unhashable.
+func literalKey(value interface{}) (string, error) {
+	switch value := value.(type) {
+	case nil:
+		return "None", nil
+	case bool:
+		if value {
+			return "int:1", nil
+		}
+		return "int:0", nil
+	case *big.Int:
+		return "int:" + value.String(), nil
+	case float64:
+		switch {
+		case math.IsNaN(value):
+			return nanKey, nil
+		case !math.IsInf(value, 0) && value == math.Trunc(value):
+			integer, _ := big.NewFloat(value).Int(nil)
+			return "int:" + integer.String(), nil
+		}
+		return "float:" + strconv.FormatFloat(value, 'g', -1, 64), nil
+	case complex128:
+		if imag(value) == 0 {
+			return literalKey(real(value))
+		}
+		realKey, _ := literalKey(real(value))
+		imagKey, _ := literalKey(imag(value))
+		return "complex:" + realKey + "," + imagKey, nil
+	case string:
+		return "str:" + strconv.Quote(value), nil
+	case []byte:
+		return "bytes:" + strconv.Quote(string(value)), nil
+	case LiteralTuple:
+		keys := make([]string, 0, len(value))
+		for _, element := range value {
+			key, err := literalKey(element)
+			if err != nil {
+				return "", err
+			}
+			keys = append(keys, key)
+		}
+		return "tuple:(" + strings.Join(keys, ", ") + ")", nil
+	}
+	return "", &ValueError{Message: fmt.Sprintf("unhashable type: '%s'", literalTypeName(value))}
+}
+
+func literalTypeName(value interface{}) string {
+	switch value.(type) {
+	case []interface{}:
+		return "list"
+	case *LiteralSet:
+		return "set"
+	case *LiteralDict:
+		return "dict"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// LiteralEval evaluates source holding a single literal expression without running any
+// code, like ast.literal_eval. Strings, bytes, numbers, tuples, lists, dicts, sets, True,
+// False and None are allowed, along with unary and binary + and - on numbers. The value
+// is nil for None, bool, *big.Int, float64, complex128, string for str, []byte for bytes,
+// []interface{} for a list, LiteralTuple, *LiteralSet or *LiteralDict.
+func LiteralEval(source string) (interface{}, error) {
+	// Like eval, leading spaces and tabs are not an indent
+	source = strings.TrimLeft(source, " \t")
+	gp := grammar.NewGrammarParser(scanner.NewStringScanner(source))
+	start := gp.Parse()
+	if len(gp.Errors) > 0 {
+		return nil, gp.Errors[0]
+	}
+	mod, err := ASTFromGrammar(start)
+	if err != nil {
+		return nil, err
+	}
+
+	body := mod.(*Module).Body
+	if len(body) != 1 {
+		return nil, &ValueError{Literal: source, Message: "malformed node or string: expected a single expression"}
+	}
+	expr, isExpr := body[0].(*Expr)
+	if !isExpr {
+		return nil, malformedLiteral(body[0])
+	}
+	return literalValue(expr.Value)
+}
+
+func malformedLiteral(node Positioned) error {
+	source := Unparse(&Module{Body: []Statement{exprStatement(node)}})
+	source = strings.TrimSpace(source)
+	return &ValueError{
+		Literal: source,
+		Message: fmt.Sprintf("malformed node or string on line %d: %s (%s)", node.Pos().Lineno, source, typeName(node)),
+	}
+}
+
+// exprStatement wraps an expression so it can be unparsed
+func exprStatement(node Positioned) Statement {
+	if expr, isExpr := node.(Expression); isExpr {
+		return NewExpr(expr)
+	}
+	return node.(Statement)
+}
+
+// isNumberNode reports whether expr is allowed as an operand of + or -
+func isNumberNode(expr Expression) bool {
+	switch expr.(type) {
+	case *Num, *UnaryOp, *BinOp:
+		return true
+	}
+	return false
+}
+
+func literalValue(expr Expression) (interface{}, error) {
+	switch expr := expr.(type) {
+	case *Str:
+		return expr.Value.String(), nil
+	case *Bytes:
+		return expr.Value.Value(), nil
+	case *Num:
+		switch value := expr.Value.(type) {
+		case *gython.Long:
+			return value.Value, nil
+		case *gython.Float:
+			return value.Value, nil
+		case *gython.Complex:
+			return complex(value.Real, value.Imag), nil
+		}
+	case *NameConstant:
+		switch expr.Value {
+		case gython.True:
+			return true, nil
+		case gython.False:
+			return false, nil
+		case gython.None:
+			return nil, nil
+		}
+	case *Tuple:
+		elements, err := literalValues(expr.Elements)
+		return LiteralTuple(elements), err
+	case *List:
+		return literalValues(expr.Elements)
+	case *Set:
+		elements, err := literalValues(expr.Elements)
+		if err != nil {
+			return nil, err
+		}
+		set := &LiteralSet{Elements: make([]interface{}, 0, len(elements)), index: make(map[string]bool)}
+		for _, element := range elements {
+			if err := set.add(element); err != nil {
+				return nil, err
+			}
+		}
+		return set, nil
+	case *Dict:
+		dict := &LiteralDict{index: make(map[string]int)}
+		for i, key := range expr.Keys {
+			// A ** unpacking has no key
+			if key == nil {
+				return nil, malformedLiteral(expr)
+			}
+			keyValue, err := literalValue(key)
+			if err != nil {
+				return nil, err
+			}
+			value, err := literalValue(expr.Values[i])
+			if err != nil {
+				return nil, err
+			}
+			if err := dict.set(keyValue, value); err != nil {
+				return nil, err
+			}
+		}
+		return dict, nil
+	case *UnaryOp:
+		_, isUAdd := expr.Operator.(*UAdd)
+		_, isUSub := expr.Operator.(*USub)
+		if (isUAdd || isUSub) && isNumberNode(expr.Operand) {
+			operand, err := literalValue(expr.Operand)
+			if err != nil || isUAdd {
+				return operand, err
+			}
+			return literalNegate(operand), nil
+		}
+	case *BinOp:
+		_, isAdd := expr.Operator.(*Add)
+		_, isSub := expr.Operator.(*Sub)
+		if (isAdd || isSub) && isNumberNode(expr.Left) && isNumberNode(expr.Right) {
+			left, err := literalValue(expr.Left)
+			if err != nil {
+				return nil, err
+			}
+			right, err := literalValue(expr.Right)
+			if err != nil {
+				return nil, err
+			}
+			if isAdd {
+				return literalAdd(left, right)
+			}
+			return literalSubtract(left, right)
+		}
+	}
+	return nil, malformedLiteral(expr)
+}
+
+func literalValues(exprs []Expression) ([]interface{}, error) {
+	values := make([]interface{}, 0, len(exprs))
+	for _, expr := range exprs {
+		value, err := literalValue(expr)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// literalNegate negates a number, which unlike subtracting it from zero flips the sign of a zero float
+func literalNegate(value interface{}) interface{} {
+	switch value := value.(type) {
+	case *big.Int:
+		return new(big.Int).Neg(value)
+	case float64:
+		return -value
+	case complex128:
+		return -value
+	}
+	return value
+}
+
+func literalAdd(left interface{}, right interface{}) (interface{}, error) {
+	return literalArithmetic(left, right, new(big.Int).Add, func(a, b complex128) complex128 { return a + b })
+}
+
+func literalSubtract(left interface{}, right interface{}) (interface{}, error) {
+	return literalArithmetic(left, right, new(big.Int).Sub, func(a, b complex128) complex128 { return a - b })
+}
+
+// literalArithmetic applies an operation to two numbers, converting the narrower one to the
+// kind of the wider, int to float to complex, as Python does
+func literalArithmetic(
+	left interface{}, right interface{}, integer func(a, b *big.Int) *big.Int, op func(a, b complex128) complex128,
+) (interface{}, error) {
+	leftInt, leftIsInt := left.(*big.Int)
+	rightInt, rightIsInt := right.(*big.Int)
+	if leftIsInt && rightIsInt {
+		return integer(leftInt, rightInt), nil
+	}
+
+	a, err := literalComplex(left)
+	if err != nil {
+		return nil, err
+	}
+	b, err := literalComplex(right)
+	if err != nil {
+		return nil, err
+	}
+	result := op(a, b)
+	_, leftIsComplex := left.(complex128)
+	_, rightIsComplex := right.(complex128)
+	if leftIsComplex || rightIsComplex {
+		return result, nil
+	}
+	return real(result), nil
+}
+
+// literalComplex converts a number to complex128, an int too large for a float is an error like in Python
+func literalComplex(value interface{}) (complex128, error) {
+	switch value := value.(type) {
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(value).Float64()
+		if math.IsInf(f, 0) {
+			return 0, &ValueError{Literal: value.String(), Message: "int too large to convert to float"}
+		}
+		return complex(f, 0), nil
+	case float64:
+		return complex(value, 0), nil
+	case complex128:
+		return value, nil
+	}
+	return 0, &ValueError{Message: fmt.Sprintf("unsupported operand type: '%T'", value)}
+}
@@ -0,0 +1,111 @@
+package ast
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLiteralEval(t *testing.T) {
+	huge, _ := new(big.Int).SetString("100000000000000000000000000000", 10)
+	tests := []struct {
+		source string
+		value  interface{}
+	}{
+		{"'spam'", "spam"},
+		{"'sp' \"am\"", "spam"},
+		{"b'\\x00sp'", []byte("\x00sp")},
+		{"42", big.NewInt(42)},
+		{"100000000000000000000000000000", huge},
+		{"-0x10", big.NewInt(-16)},
+		{"1.5", 1.5},
+		{"2j", complex(0, 2)},
+		{"1 - 2j", complex(1, -2)},
+		{"-(1 + 2) - -5", big.NewInt(2)},
+		{"1 + 0.5", 1.5},
+		{"+-+1", big.NewInt(-1)},
+		{"True", true},
+		{"False", false},
+		{"None", nil},
+		{"()", LiteralTuple{}},
+		{"(1, 'a')", LiteralTuple{big.NewInt(1), "a"}},
+		{"[1, [2]]", []interface{}{big.NewInt(1), []interface{}{big.NewInt(2)}}},
+		{"  [\n    1,\n]\n", []interface{}{big.NewInt(1)}},
+		{strings.Repeat("(", 200) + "1" + strings.Repeat(")", 200), big.NewInt(1)},
+	}
+	for _, test := range tests {
+		value, err := LiteralEval(test.source)
+		if err != nil {
+			t.Errorf("LiteralEval(%q) returned an error: %s", test.source, err)
+		} else if !reflect.DeepEqual(value, test.value) {
+			t.Errorf("LiteralEval(%q) = %#v, want %#v", test.source, value, test.value)
+		}
+	}
+}
+
+func TestLiteralEvalNegativeZero(t *testing.T) {
+	value, err := LiteralEval("-0.0")
+	if f, isFloat := value.(float64); err != nil || !isFloat || f != 0 || !math.Signbit(f) {
+		t.Errorf("LiteralEval(\"-0.0\") = %#v, %v", value, err)
+	}
+}
+
+func TestLiteralEvalSetsAndDicts(t *testing.T) {
+	value, err := LiteralEval("{1, 1.0, True, 2, (1, 2), (1.0, 2)}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := value.(*LiteralSet)
+	if want := []interface{}{big.NewInt(1), big.NewInt(2), LiteralTuple{big.NewInt(1), big.NewInt(2)}}; !reflect.DeepEqual(set.Elements, want) {
+		t.Errorf("set elements are %#v, want %#v", set.Elements, want)
+	}
+	if !set.Contains(2.0) || set.Contains("2") {
+		t.Errorf("set membership does not follow Python equality")
+	}
+
+	value, err = LiteralEval("{'a': 1, 1: 'one', 1.0: 'uno', 'b': {}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dict := value.(*LiteralDict)
+	if want := []interface{}{"a", big.NewInt(1), "b"}; !reflect.DeepEqual(dict.Keys, want) {
+		t.Errorf("dict keys are %#v, want %#v", dict.Keys, want)
+	}
+	if one, ok := dict.Get(true); !ok || one != "uno" {
+		t.Errorf("dict.Get(true) = %#v, %v", one, ok)
+	}
+	if _, ok := dict.Get("c"); ok {
+		t.Errorf("dict.Get(\"c\") found a value")
+	}
+}
+
+func TestLiteralEvalErrors(t *testing.T) {
+	tests := []struct {
+		source string
+		err    string
+	}{
+		{"os.system('ls')", "malformed node or string on line 1: os.system('ls') (Call)"},
+		{"x = 1", "malformed node or string on line 1: x = 1 (Assign)"},
+		{"[1,\n 2 ** 8]", "malformed node or string on line 2: 2 ** 8 (BinOp)"},
+		{"-'a'", "malformed node or string on line 1: -'a' (UnaryOp)"},
+		{"'a' + 'b'", "malformed node or string on line 1: 'a' + 'b' (BinOp)"},
+		{"{**a}", "malformed node or string on line 1: {**a} (Dict)"},
+		{"1; 2", "malformed node or string: expected a single expression"},
+		{"{[1]: 2}", "unhashable type: 'list'"},
+		{"{{1}}", "unhashable type: 'set'"},
+		{"100000000000000000000000000000" + strings.Repeat("0", 300) + " + 1.0", "int too large to convert to float"},
+		{"[1", "invalid syntax"},
+		{strings.Repeat("[", 100000), "too many nested parentheses"},
+		{strings.Repeat("(", 100000) + strings.Repeat(")", 100000), "too many nested parentheses"},
+		{strings.Repeat("-", 3000000) + "1", "s_push: parser stack overflow"},
+		{strings.Repeat("not ", 3000000) + "1", "s_push: parser stack overflow"},
+	}
+	for _, test := range tests {
+		value, err := LiteralEval(test.source)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("LiteralEval(%q) = %#v, %v, want the error %q", test.source, value, err, test.err)
+		}
+	}
+}
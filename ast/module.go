@@ -1,9 +1,6 @@
 package ast
 
-import (
-	"fmt"
-	"strings"
-)
+import "fmt"
 
 type Mod interface {
 	Node
@@ -25,10 +22,12 @@ func (module *Module) mod()  {}
 func (module *Module) Append(stmt Statement) {
 	module.Body = append(module.Body, stmt)
 }
-func (module *Module) String() string {
-	stmts := make([]string, 0)
-	for _, stmt := range module.Body {
-		stmts = append(stmts, stmt.String())
-	}
-	return fmt.Sprintf("Module(body=[%s])", strings.Join(stmts, ", "))
+func (module *Module) String() string { return Dump(module, false) }
+func (module *Module) dump(dumper *dumper) string {
+	return fmt.Sprintf("Module(body=%s)", dumper.statements(module.Body))
+}
+func (module *Module) Fields() []string     { return []string{"body"} }
+func (module *Module) Attributes() []string { return nil }
+func (module *Module) fieldPointers() []interface{} {
+	return []interface{}{&module.Body}
 }
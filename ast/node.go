@@ -3,4 +3,26 @@ package ast
 type Node interface {
 	node()
 	String() string
+	// Fields returns the names of the node's fields, like _fields in Python's ast module
+	Fields() []string
+	// Attributes returns the names of the node's attributes, like _attributes
+	Attributes() []string
+}
+
+// Position is where a node starts in the source, Lineno counts from 1 and
+// ColOffset is the byte offset into that line
+type Position struct {
+	Lineno    int
+	ColOffset int
+}
+
+func (position *Position) Pos() *Position { return position }
+
+// Attributes returns the attributes every positioned node has
+func (position *Position) Attributes() []string { return []string{"lineno", "col_offset"} }
+
+// Positioned is a node carrying the lineno and col_offset attributes
+type Positioned interface {
+	Node
+	Pos() *Position
 }
@@ -0,0 +1,156 @@
+package ast
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/brettlangdon/gython/gython"
+)
+
+// ValueError reports a literal which cannot be converted to a value, like Python's ValueError
+type ValueError struct {
+	Literal string
+	Message string
+}
+
+func (err *ValueError) Error() string {
+	return err.Message
+}
+
+func newIntValueError(literal string) *ValueError {
+	return &ValueError{
+		Literal: literal,
+		Message: fmt.Sprintf("invalid literal for int() with base 0: '%s'", literal),
+	}
+}
+
+// ParseNumber converts the literal of a NUMBER token into a *gython.Long, *gython.Float or
+// *gython.Complex, following CPython's parsenumber. Integers have arbitrary precision and
+// floats are correctly rounded.
+func ParseNumber(literal string) (gython.Object, error) {
+	s, ok := stripUnderscores(literal)
+	if !ok || s == "" {
+		return nil, newIntValueError(literal)
+	}
+
+	switch last := s[len(s)-1]; {
+	case last == 'j' || last == 'J':
+		imag, ok := parseFloat(s[:len(s)-1])
+		if !ok {
+			return nil, &ValueError{Literal: literal, Message: "complex() arg is a malformed string"}
+		}
+		return gython.NewComplex(0, imag), nil
+	case strings.ContainsAny(s, ".eE") && !isRadixLiteral(s):
+		value, ok := parseFloat(s)
+		if !ok {
+			return nil, &ValueError{
+				Literal: literal,
+				Message: fmt.Sprintf("could not convert string to float: '%s'", literal),
+			}
+		}
+		return gython.NewFloat(value), nil
+	}
+
+	value, ok := parseInt(s)
+	if !ok {
+		return nil, newIntValueError(literal)
+	}
+	return gython.NewLong(value), nil
+}
+
+func isRadixLiteral(s string) bool {
+	return len(s) > 1 && s[0] == '0' && strings.IndexByte("xXoObB", s[1]) >= 0
+}
+
+// parseInt parses a decimal, hexadecimal, octal or binary integer without underscores
+func parseInt(s string) (*big.Int, bool) {
+	base := 10
+	digits := s
+	if isRadixLiteral(s) {
+		switch s[1] {
+		case 'x', 'X':
+			base = 16
+		case 'o', 'O':
+			base = 8
+		case 'b', 'B':
+			base = 2
+		}
+		digits = s[2:]
+	} else if strings.TrimLeft(s, "0") != "" && s[0] == '0' {
+		// Leading zeros are only allowed on zero, 012 is not octal
+		return nil, false
+	}
+	if digits == "" || digits[0] == '+' || digits[0] == '-' {
+		return nil, false
+	}
+
+	value, ok := new(big.Int).SetString(digits, base)
+	return value, ok
+}
+
+// parseFloat parses a decimal floating point number without underscores, values
+// too large for a float64 become infinite as they do in CPython
+func parseFloat(s string) (float64, bool) {
+	sawDigit, sawPoint, sawExponent := false, false, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case '0' <= c && c <= '9':
+			sawDigit = true
+		case c == '.' && !sawPoint && !sawExponent:
+			sawPoint = true
+		case (c == 'e' || c == 'E') && sawDigit && !sawExponent:
+			sawExponent = true
+			if i+1 < len(s) && (s[i+1] == '+' || s[i+1] == '-') {
+				i++
+			}
+			if i+1 >= len(s) {
+				return 0, false
+			}
+		default:
+			return 0, false
+		}
+	}
+	if !sawDigit {
+		return 0, false
+	}
+
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil && err.(*strconv.NumError).Err != strconv.ErrRange {
+		return 0, false
+	}
+	return value, true
+}
+
+// stripUnderscores removes the underscores allowed between digits since Python 3.6
+func stripUnderscores(s string) (string, bool) {
+	if strings.IndexByte(s, '_') < 0 {
+		return s, true
+	}
+
+	isDigit := func(c byte) bool {
+		return '0' <= c && c <= '9'
+	}
+	if len(s) > 1 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		isDigit = func(c byte) bool {
+			return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+		}
+	}
+
+	stripped := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '_' {
+			stripped = append(stripped, s[i])
+			continue
+		}
+		afterPrefix := i == 2 && isRadixLiteral(s)
+		if !afterPrefix && (i == 0 || !isDigit(s[i-1])) {
+			return "", false
+		}
+		if i+1 >= len(s) || !isDigit(s[i+1]) {
+			return "", false
+		}
+	}
+	return string(stripped), true
+}
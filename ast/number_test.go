@@ -0,0 +1,105 @@
+package ast
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/brettlangdon/gython/gython"
+	"github.com/brettlangdon/gython/scanner"
+)
+
+func TestParseNumber(t *testing.T) {
+	huge, _ := new(big.Int).SetString("1000000000000000000000000000000", 10)
+	tests := []struct {
+		literal string
+		value   gython.Object
+	}{
+		{"0", gython.NewLong(big.NewInt(0))},
+		{"000", gython.NewLong(big.NewInt(0))},
+		{"42", gython.NewLong(big.NewInt(42))},
+		{"0x1F", gython.NewLong(big.NewInt(31))},
+		{"0XfF", gython.NewLong(big.NewInt(255))},
+		{"0o17", gython.NewLong(big.NewInt(15))},
+		{"0b101", gython.NewLong(big.NewInt(5))},
+		{"1000000000000000000000000000000", gython.NewLong(huge)},
+		{"1_000", gython.NewLong(big.NewInt(1000))},
+		{"0x_ff", gython.NewLong(big.NewInt(255))},
+		{"1.5", gython.NewFloat(1.5)},
+		{"1.", gython.NewFloat(1)},
+		{".5", gython.NewFloat(0.5)},
+		{"1e3", gython.NewFloat(1000)},
+		{"1E-3", gython.NewFloat(0.001)},
+		{"0.1", gython.NewFloat(0.1)},
+		{"1e400", gython.NewFloat(math.Inf(1))},
+		{"1e-400", gython.NewFloat(0)},
+		{"00.5", gython.NewFloat(0.5)},
+		{"1_0.0_1", gython.NewFloat(10.01)},
+		{"2j", gython.NewComplex(0, 2)},
+		{"1.5J", gython.NewComplex(0, 1.5)},
+		{"1e400j", gython.NewComplex(0, math.Inf(1))},
+		{"012j", gython.NewComplex(0, 12)},
+	}
+	for _, test := range tests {
+		value, err := ParseNumber(test.literal)
+		if err != nil {
+			t.Errorf("ParseNumber(%q) returned an error: %s", test.literal, err)
+			continue
+		}
+		var equal bool
+		switch want := test.value.(type) {
+		case *gython.Long:
+			got, isLong := value.(*gython.Long)
+			equal = isLong && got.Value.Cmp(want.Value) == 0
+		case *gython.Float:
+			got, isFloat := value.(*gython.Float)
+			equal = isFloat && got.Value == want.Value
+		case *gython.Complex:
+			got, isComplex := value.(*gython.Complex)
+			equal = isComplex && got.Real == want.Real && got.Imag == want.Imag
+		}
+		if !equal {
+			t.Errorf("ParseNumber(%q) = %#v, want %#v", test.literal, value, test.value)
+		}
+	}
+}
+
+func TestParseNumberErrors(t *testing.T) {
+	tests := []struct {
+		literal string
+		err     string
+	}{
+		{"012", "invalid literal for int() with base 0: '012'"},
+		{"0x", "invalid literal for int() with base 0: '0x'"},
+		{"0b2", "invalid literal for int() with base 0: '0b2'"},
+		{"1__0", "invalid literal for int() with base 0: '1__0'"},
+		{"1_", "invalid literal for int() with base 0: '1_'"},
+		{"1e", "could not convert string to float: '1e'"},
+		{"1.5.5", "could not convert string to float: '1.5.5'"},
+		{"1ej", "complex() arg is a malformed string"},
+	}
+	for _, test := range tests {
+		value, err := ParseNumber(test.literal)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("ParseNumber(%q) = %#v, %v, want the error %q", test.literal, value, err, test.err)
+		}
+	}
+}
+
+func TestNumberUnderscoresVersion(t *testing.T) {
+	for _, version := range []scanner.Version{scanner.Python35, scanner.Python36} {
+		tokenizer := scanner.NewBytesScanner([]byte("1_000\n"))
+		tokenizer.SetVersion(version)
+		tok := tokenizer.NextToken()
+		if !version.AtLeast(scanner.Python36) {
+			if err := tokenizer.Err(); err == nil || err.Message != "numeric literal underscore requires Python 3.6" {
+				t.Errorf("scanning 1_000 for Python %s returned %s, %v", version, tok, err)
+			}
+			continue
+		}
+		value, err := ParseNumber(tok.Literal)
+		if long, isLong := value.(*gython.Long); err != nil || !isLong || long.Value.Int64() != 1000 {
+			t.Errorf("1_000 for Python %s parsed as %#v, %v", version, value, err)
+		}
+	}
+}
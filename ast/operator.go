@@ -0,0 +1,403 @@
+package ast
+
+// Operator is the operator of a BinOp or AugAssign
+type Operator interface {
+	Node
+	operator()
+}
+
+type Add struct{}
+
+func NewAdd() *Add {
+	return &Add{}
+}
+
+func (add *Add) node()          {}
+func (add *Add) operator()      {}
+func (add *Add) String() string { return "Add()" }
+
+func (add *Add) Fields() []string     { return nil }
+func (add *Add) Attributes() []string { return nil }
+
+type Sub struct{}
+
+func NewSub() *Sub {
+	return &Sub{}
+}
+
+func (sub *Sub) node()          {}
+func (sub *Sub) operator()      {}
+func (sub *Sub) String() string { return "Sub()" }
+
+func (sub *Sub) Fields() []string     { return nil }
+func (sub *Sub) Attributes() []string { return nil }
+
+type Mult struct{}
+
+func NewMult() *Mult {
+	return &Mult{}
+}
+
+func (mult *Mult) node()          {}
+func (mult *Mult) operator()      {}
+func (mult *Mult) String() string { return "Mult()" }
+
+func (mult *Mult) Fields() []string     { return nil }
+func (mult *Mult) Attributes() []string { return nil }
+
+type MatMult struct{}
+
+func NewMatMult() *MatMult {
+	return &MatMult{}
+}
+
+func (matMult *MatMult) node()          {}
+func (matMult *MatMult) operator()      {}
+func (matMult *MatMult) String() string { return "MatMult()" }
+
+func (matMult *MatMult) Fields() []string     { return nil }
+func (matMult *MatMult) Attributes() []string { return nil }
+
+type Div struct{}
+
+func NewDiv() *Div {
+	return &Div{}
+}
+
+func (div *Div) node()          {}
+func (div *Div) operator()      {}
+func (div *Div) String() string { return "Div()" }
+
+func (div *Div) Fields() []string     { return nil }
+func (div *Div) Attributes() []string { return nil }
+
+// Modulo is the Mod operator, renamed so it does not clash with the Mod node type
+type Modulo struct{}
+
+func NewModulo() *Modulo {
+	return &Modulo{}
+}
+
+func (modulo *Modulo) node()          {}
+func (modulo *Modulo) operator()      {}
+func (modulo *Modulo) String() string { return "Mod()" }
+
+func (modulo *Modulo) Fields() []string     { return nil }
+func (modulo *Modulo) Attributes() []string { return nil }
+
+type Pow struct{}
+
+func NewPow() *Pow {
+	return &Pow{}
+}
+
+func (pow *Pow) node()          {}
+func (pow *Pow) operator()      {}
+func (pow *Pow) String() string { return "Pow()" }
+
+func (pow *Pow) Fields() []string     { return nil }
+func (pow *Pow) Attributes() []string { return nil }
+
+type LShift struct{}
+
+func NewLShift() *LShift {
+	return &LShift{}
+}
+
+func (lShift *LShift) node()          {}
+func (lShift *LShift) operator()      {}
+func (lShift *LShift) String() string { return "LShift()" }
+
+func (lShift *LShift) Fields() []string     { return nil }
+func (lShift *LShift) Attributes() []string { return nil }
+
+type RShift struct{}
+
+func NewRShift() *RShift {
+	return &RShift{}
+}
+
+func (rShift *RShift) node()          {}
+func (rShift *RShift) operator()      {}
+func (rShift *RShift) String() string { return "RShift()" }
+
+func (rShift *RShift) Fields() []string     { return nil }
+func (rShift *RShift) Attributes() []string { return nil }
+
+type BitOr struct{}
+
+func NewBitOr() *BitOr {
+	return &BitOr{}
+}
+
+func (bitOr *BitOr) node()          {}
+func (bitOr *BitOr) operator()      {}
+func (bitOr *BitOr) String() string { return "BitOr()" }
+
+func (bitOr *BitOr) Fields() []string     { return nil }
+func (bitOr *BitOr) Attributes() []string { return nil }
+
+type BitXor struct{}
+
+func NewBitXor() *BitXor {
+	return &BitXor{}
+}
+
+func (bitXor *BitXor) node()          {}
+func (bitXor *BitXor) operator()      {}
+func (bitXor *BitXor) String() string { return "BitXor()" }
+
+func (bitXor *BitXor) Fields() []string     { return nil }
+func (bitXor *BitXor) Attributes() []string { return nil }
+
+type BitAnd struct{}
+
+func NewBitAnd() *BitAnd {
+	return &BitAnd{}
+}
+
+func (bitAnd *BitAnd) node()          {}
+func (bitAnd *BitAnd) operator()      {}
+func (bitAnd *BitAnd) String() string { return "BitAnd()" }
+
+func (bitAnd *BitAnd) Fields() []string     { return nil }
+func (bitAnd *BitAnd) Attributes() []string { return nil }
+
+type FloorDiv struct{}
+
+func NewFloorDiv() *FloorDiv {
+	return &FloorDiv{}
+}
+
+func (floorDiv *FloorDiv) node()          {}
+func (floorDiv *FloorDiv) operator()      {}
+func (floorDiv *FloorDiv) String() string { return "FloorDiv()" }
+
+func (floorDiv *FloorDiv) Fields() []string     { return nil }
+func (floorDiv *FloorDiv) Attributes() []string { return nil }
+
+// UnaryOperator is the operator of a UnaryOp
+type UnaryOperator interface {
+	Node
+	unaryOp()
+}
+
+type Invert struct{}
+
+func NewInvert() *Invert {
+	return &Invert{}
+}
+
+func (invert *Invert) node()          {}
+func (invert *Invert) unaryOp()       {}
+func (invert *Invert) String() string { return "Invert()" }
+
+func (invert *Invert) Fields() []string     { return nil }
+func (invert *Invert) Attributes() []string { return nil }
+
+type Not struct{}
+
+func NewNot() *Not {
+	return &Not{}
+}
+
+func (notOp *Not) node()          {}
+func (notOp *Not) unaryOp()       {}
+func (notOp *Not) String() string { return "Not()" }
+
+func (notOp *Not) Fields() []string     { return nil }
+func (notOp *Not) Attributes() []string { return nil }
+
+type UAdd struct{}
+
+func NewUAdd() *UAdd {
+	return &UAdd{}
+}
+
+func (uAdd *UAdd) node()          {}
+func (uAdd *UAdd) unaryOp()       {}
+func (uAdd *UAdd) String() string { return "UAdd()" }
+
+func (uAdd *UAdd) Fields() []string     { return nil }
+func (uAdd *UAdd) Attributes() []string { return nil }
+
+type USub struct{}
+
+func NewUSub() *USub {
+	return &USub{}
+}
+
+func (uSub *USub) node()          {}
+func (uSub *USub) unaryOp()       {}
+func (uSub *USub) String() string { return "USub()" }
+
+func (uSub *USub) Fields() []string     { return nil }
+func (uSub *USub) Attributes() []string { return nil }
+
+// BooleanOperator is the operator of a BoolOp
+type BooleanOperator interface {
+	Node
+	boolOp()
+}
+
+type And struct{}
+
+func NewAnd() *And {
+	return &And{}
+}
+
+func (andOp *And) node()          {}
+func (andOp *And) boolOp()        {}
+func (andOp *And) String() string { return "And()" }
+
+func (andOp *And) Fields() []string     { return nil }
+func (andOp *And) Attributes() []string { return nil }
+
+type Or struct{}
+
+func NewOr() *Or {
+	return &Or{}
+}
+
+func (orOp *Or) node()          {}
+func (orOp *Or) boolOp()        {}
+func (orOp *Or) String() string { return "Or()" }
+
+func (orOp *Or) Fields() []string     { return nil }
+func (orOp *Or) Attributes() []string { return nil }
+
+// CompareOperator is one of the operators of a Compare
+type CompareOperator interface {
+	Node
+	cmpOp()
+}
+
+type Eq struct{}
+
+func NewEq() *Eq {
+	return &Eq{}
+}
+
+func (eq *Eq) node()          {}
+func (eq *Eq) cmpOp()         {}
+func (eq *Eq) String() string { return "Eq()" }
+
+func (eq *Eq) Fields() []string     { return nil }
+func (eq *Eq) Attributes() []string { return nil }
+
+type NotEq struct{}
+
+func NewNotEq() *NotEq {
+	return &NotEq{}
+}
+
+func (notEq *NotEq) node()          {}
+func (notEq *NotEq) cmpOp()         {}
+func (notEq *NotEq) String() string { return "NotEq()" }
+
+func (notEq *NotEq) Fields() []string     { return nil }
+func (notEq *NotEq) Attributes() []string { return nil }
+
+type Lt struct{}
+
+func NewLt() *Lt {
+	return &Lt{}
+}
+
+func (lt *Lt) node()          {}
+func (lt *Lt) cmpOp()         {}
+func (lt *Lt) String() string { return "Lt()" }
+
+func (lt *Lt) Fields() []string     { return nil }
+func (lt *Lt) Attributes() []string { return nil }
+
+type LtE struct{}
+
+func NewLtE() *LtE {
+	return &LtE{}
+}
+
+func (ltE *LtE) node()          {}
+func (ltE *LtE) cmpOp()         {}
+func (ltE *LtE) String() string { return "LtE()" }
+
+func (ltE *LtE) Fields() []string     { return nil }
+func (ltE *LtE) Attributes() []string { return nil }
+
+type Gt struct{}
+
+func NewGt() *Gt {
+	return &Gt{}
+}
+
+func (gt *Gt) node()          {}
+func (gt *Gt) cmpOp()         {}
+func (gt *Gt) String() string { return "Gt()" }
+
+func (gt *Gt) Fields() []string     { return nil }
+func (gt *Gt) Attributes() []string { return nil }
+
+type GtE struct{}
+
+func NewGtE() *GtE {
+	return &GtE{}
+}
+
+func (gtE *GtE) node()          {}
+func (gtE *GtE) cmpOp()         {}
+func (gtE *GtE) String() string { return "GtE()" }
+
+func (gtE *GtE) Fields() []string     { return nil }
+func (gtE *GtE) Attributes() []string { return nil }
+
+type Is struct{}
+
+func NewIs() *Is {
+	return &Is{}
+}
+
+func (isOp *Is) node()          {}
+func (isOp *Is) cmpOp()         {}
+func (isOp *Is) String() string { return "Is()" }
+
+func (isOp *Is) Fields() []string     { return nil }
+func (isOp *Is) Attributes() []string { return nil }
+
+type IsNot struct{}
+
+func NewIsNot() *IsNot {
+	return &IsNot{}
+}
+
+func (isNot *IsNot) node()          {}
+func (isNot *IsNot) cmpOp()         {}
+func (isNot *IsNot) String() string { return "IsNot()" }
+
+func (isNot *IsNot) Fields() []string     { return nil }
+func (isNot *IsNot) Attributes() []string { return nil }
+
+type In struct{}
+
+func NewIn() *In {
+	return &In{}
+}
+
+func (inOp *In) node()          {}
+func (inOp *In) cmpOp()         {}
+func (inOp *In) String() string { return "In()" }
+
+func (inOp *In) Fields() []string     { return nil }
+func (inOp *In) Attributes() []string { return nil }
+
+type NotIn struct{}
+
+func NewNotIn() *NotIn {
+	return &NotIn{}
+}
+
+func (notIn *NotIn) node()          {}
+func (notIn *NotIn) cmpOp()         {}
+func (notIn *NotIn) String() string { return "NotIn()" }
+
+func (notIn *NotIn) Fields() []string     { return nil }
+func (notIn *NotIn) Attributes() []string { return nil }
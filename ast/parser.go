@@ -2,20 +2,37 @@ package ast
 
 import (
 	"fmt"
-	"strconv"
+	"strings"
 
 	"github.com/brettlangdon/gython/grammar"
-	"github.com/brettlangdon/gython/symbol"
+	"github.com/brettlangdon/gython/gython"
 	"github.com/brettlangdon/gython/token"
 )
 
+// grammarList is a grammar node made of a sequence of children
+type grammarList interface {
+	grammar.Node
+	Children() []grammar.Node
+}
+
+// grammarParent is a grammar node which stands for its single child
+type grammarParent interface {
+	grammar.Node
+	Child() grammar.Node
+}
+
 func ASTFromGrammar(root *grammar.FileInput) (Mod, error) {
 	mod := NewModule()
 
 	for _, child := range root.Children() {
-		if child.ID() == symbol.STMT {
-			stmt := astForStatement(child.(*grammar.Statement))
-			mod.Append(stmt)
+		if stmt, isStmt := child.(*grammar.Statement); isStmt {
+			stmts, err := astForStatement(stmt)
+			if err != nil {
+				return nil, err
+			}
+			for _, stmt := range stmts {
+				mod.Append(stmt)
+			}
 		}
 	}
 
@@ -29,168 +46,1791 @@ func isToken(node grammar.Node, tokId token.TokenID) bool {
 	return false
 }
 
-func astForStatement(root *grammar.Statement) Statement {
-	stmt := root.Child()
+// isLiteral reports whether node is the keyword or name literal
+func isLiteral(node grammar.Node, literal string) bool {
+	if n, isTokenNode := node.(*grammar.TokenNode); isTokenNode {
+		return n.Token.IsLiteral(literal)
+	}
+	return false
+}
+
+// tokenLiteral returns the literal of a token node
+func tokenLiteral(node grammar.Node) string {
+	return node.(*grammar.TokenNode).Token.Literal
+}
+
+// identifier returns the literal of a NAME token node as an identifier
+func identifier(node grammar.Node) *gython.Unicode {
+	return gython.NewUnicode([]byte(tokenLiteral(node)))
+}
+
+// startToken returns the first token of a grammar node
+func startToken(root grammar.Node) *token.Token {
+	for {
+		switch node := root.(type) {
+		case *grammar.TokenNode:
+			return node.Token
+		case grammarList:
+			root = node.Children()[0]
+		case grammarParent:
+			root = node.Child()
+		default:
+			return nil
+		}
+	}
+}
+
+// setPosition places node at the start of root, like LINENO(n) and n->n_col_offset in ast.c
+func setPosition(node Positioned, root grammar.Node) {
+	tok := startToken(root)
+	node.Pos().Lineno = tok.LineStart
+	node.Pos().ColOffset = tok.ColumnStart
+}
+
+// expressionAt places expr at the start of root and returns it
+func expressionAt(expr Expression, root grammar.Node) Expression {
+	setPosition(expr, root)
+	return expr
+}
+
+// statementAt places stmt at the start of root and returns it
+func statementAt(stmt Statement, root grammar.Node) Statement {
+	setPosition(stmt, root)
+	return stmt
+}
+
+// forbiddenName reports a name which cannot be bound, None, True and False are only checked with fullChecks
+func forbiddenName(name string, root grammar.Node, fullChecks bool) error {
+	if name == "__debug__" {
+		return newSyntaxError(root, "assignment to keyword")
+	}
+	if fullChecks && (name == "None" || name == "True" || name == "False") {
+		return newSyntaxError(root, "assignment to keyword")
+	}
+	return nil
+}
+
+// setContext marks expr, lowered from root, as the target of an assignment (Store) or a del statement (Del),
+// like set_context in ast.c. A starred target is only allowed inside a List or Tuple.
+func setContext(expr Expression, ctx ExpressionContext, root grammar.Node) error {
+	if starred, isStarred := expr.(*Starred); isStarred {
+		if _, isDel := ctx.(*Del); isDel {
+			return syntaxErrorAt(starred, "can't use starred expression here")
+		}
+		return syntaxErrorAt(starred, "starred assignment target must be in a list or tuple")
+	}
+	return setElementContext(expr, ctx, root)
+}
 
-	if stmt.ID() == symbol.SIMPLE_STMT {
-		stmt = stmt.(*grammar.SimpleStatement).Children()[0]
+// setElementContext sets ctx on expr and the elements of a List or Tuple target
+func setElementContext(expr Expression, ctx ExpressionContext, root grammar.Node) error {
+	_, isStore := ctx.(*Store)
+	var elements []Expression
+	exprName := ""
+	switch expr := expr.(type) {
+	case *Attribute:
+		expr.Context = ctx
+		if isStore {
+			if err := forbiddenName(expr.Attr.String(), root, true); err != nil {
+				return err
+			}
+		}
+	case *Subscript:
+		expr.Context = ctx
+	case *Starred:
+		if !isStore {
+			return syntaxErrorAt(expr, "can't use starred expression here")
+		}
+		expr.Context = ctx
+		return setElementContext(expr.Value, ctx, root)
+	case *Name:
+		if isStore {
+			if err := forbiddenName(expr.Identifier.String(), root, false); err != nil {
+				return err
+			}
+		}
+		expr.Context = ctx
+	case *List:
+		expr.Context = ctx
+		elements = expr.Elements
+	case *Tuple:
+		expr.Context = ctx
+		elements = expr.Elements
+	case *Lambda:
+		exprName = "lambda"
+	case *Call:
+		exprName = "function call"
+	case *BoolOp, *BinOp, *UnaryOp:
+		exprName = "operator"
+	case *GeneratorExp:
+		exprName = "generator expression"
+	case *Yield, *YieldFrom:
+		exprName = "yield expression"
+	case *Await:
+		exprName = "await expression"
+	case *ListComp:
+		exprName = "list comprehension"
+	case *SetComp:
+		exprName = "set comprehension"
+	case *DictComp:
+		exprName = "dict comprehension"
+	case *Dict, *Set, *Num, *Str, *Bytes:
+		exprName = "literal"
+	case *NameConstant:
+		exprName = "keyword"
+	case *Ellipsis:
+		exprName = "Ellipsis"
+	case *Compare:
+		exprName = "comparison"
+	case *IfExp:
+		exprName = "conditional expression"
+	default:
+		return newSyntaxError(root, "unexpected expression in assignment")
 	}
 
-	switch stmt := stmt.(type) {
-	case *grammar.SmallStatement:
-		switch child := stmt.Child().(type) {
-		case *grammar.ExpressionStatement:
-			return astForExpressionStatement(child)
+	if exprName != "" {
+		verb := "delete"
+		if isStore {
+			verb = "assign to"
+		}
+		return newSyntaxError(root, fmt.Sprintf("can't %s %s", verb, exprName))
+	}
+	for _, element := range elements {
+		if err := setElementContext(element, ctx, root); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
+// astForStatement returns the statements of a stmt, a simple_stmt can hold several separated by ';'
+func astForStatement(root *grammar.Statement) ([]Statement, error) {
+	switch stmt := root.Child().(type) {
+	case *grammar.SimpleStatement:
+		return astForSimpleStatement(stmt)
 	case *grammar.CompoundStatement:
-		fmt.Println(stmt)
+		compound, err := astForCompoundStatement(stmt)
+		if err != nil {
+			return nil, err
+		}
+		return []Statement{compound}, nil
+	}
+	return nil, unexpectedNode(root.Child())
+}
+
+func astForSimpleStatement(root *grammar.SimpleStatement) ([]Statement, error) {
+	stmts := make([]Statement, 0)
+	for _, child := range root.Children() {
+		if smallStmt, isSmallStmt := child.(*grammar.SmallStatement); isSmallStmt {
+			stmt, err := astForSmallStatement(smallStmt)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, stmt)
+		}
+	}
+	return stmts, nil
+}
+
+func astForSmallStatement(root *grammar.SmallStatement) (Statement, error) {
+	switch stmt := root.Child().(type) {
+	case *grammar.ExpressionStatement:
+		return astForExpressionStatement(stmt)
+	case *grammar.DeleteStatement:
+		// del_stmt: 'del' exprlist
+		targets, err := seqForTestlist(stmt.Children()[1].(grammarList))
+		if err != nil {
+			return nil, err
+		}
+		children := stmt.Children()[1].(grammarList).Children()
+		for i, target := range targets {
+			if err := setContext(target, NewDel(), children[i*2]); err != nil {
+				return nil, err
+			}
+		}
+		return statementAt(NewDelete(targets), stmt), nil
+	case *grammar.PassStatement:
+		return statementAt(NewPass(), stmt), nil
+	case *grammar.FlowStatement:
+		return astForFlowStatement(stmt)
+	case *grammar.ImportStatement:
+		return astForImportStatement(stmt)
+	case *grammar.GlobalStatement:
+		return statementAt(NewGlobal(namesForStatement(stmt.Children())), stmt), nil
+	case *grammar.NonlocalStatement:
+		return statementAt(NewNonlocal(namesForStatement(stmt.Children())), stmt), nil
+	case *grammar.AssertStatement:
+		// assert_stmt: 'assert' test [',' test]
+		children := stmt.Children()
+		test, err := astForExpression(children[1])
+		if err != nil {
+			return nil, err
+		}
+		var msg Expression
+		if len(children) == 4 {
+			if msg, err = astForExpression(children[3]); err != nil {
+				return nil, err
+			}
+		}
+		return statementAt(NewAssert(test, msg), stmt), nil
+	}
+	return nil, unexpectedNode(root.Child())
+}
+
+// namesForStatement returns the NAME tokens of a global_stmt or nonlocal_stmt
+func namesForStatement(children []grammar.Node) []*gython.Unicode {
+	names := make([]*gython.Unicode, 0)
+	for i := 1; i < len(children); i += 2 {
+		names = append(names, identifier(children[i]))
+	}
+	return names
+}
+
+// expr_stmt: testlist_star_expr (annassign | augassign (yield_expr|testlist) |
+//                      ('=' (yield_expr|testlist_star_expr))*)
+func astForExpressionStatement(root *grammar.ExpressionStatement) (Statement, error) {
+	children := root.Children()
+	if len(children) == 1 {
+		value, err := astForTestlist(children[0].(grammarList))
+		if err != nil {
+			return nil, err
+		}
+		return statementAt(NewExpr(value), root), nil
+	}
+
+	switch child := children[1].(type) {
+	case *grammar.AugmentedAssignment:
+		target, err := astForTestlist(children[0].(grammarList))
+		if err != nil {
+			return nil, err
+		}
+		if err := setContext(target, NewStore(), children[0]); err != nil {
+			return nil, err
+		}
+		switch target.(type) {
+		case *Name, *Attribute, *Subscript:
+		default:
+			return nil, newSyntaxError(children[0], "illegal expression for augmented assignment")
+		}
+		value, err := astForAssignedValue(children[2])
+		if err != nil {
+			return nil, err
+		}
+		return statementAt(NewAugAssign(target, astForAugmentedAssignment(child), value), root), nil
+	case *grammar.AnnotatedAssignment:
+		return nil, newSyntaxError(child, "variable annotations are not supported by the Python 3.5 AST")
+	}
+
+	length := len(children)
+	targets := make([]Expression, 0)
+	for i := 0; i < length-1; i += 2 {
+		if _, isYield := children[i].(*grammar.YieldExpression); isYield {
+			return nil, newSyntaxError(children[i], "assignment to yield expression not possible")
+		}
+		target, err := astForAssignedValue(children[i])
+		if err != nil {
+			return nil, err
+		}
+		if err := setContext(target, NewStore(), children[i]); err != nil {
+			return nil, err
+		}
+		targets = append(targets, target)
+	}
+
+	value, err := astForAssignedValue(children[length-1])
+	if err != nil {
+		return nil, err
+	}
+	assign := NewAssign(value)
+	for _, target := range targets {
+		assign.Append(target)
+	}
+	return statementAt(assign, root), nil
+}
+
+// astForAssignedValue lowers the yield_expr, testlist or testlist_star_expr on either side of an '='
+func astForAssignedValue(root grammar.Node) (Expression, error) {
+	if yieldExpr, isYield := root.(*grammar.YieldExpression); isYield {
+		return astForExpression(yieldExpr)
+	}
+	return astForTestlist(root.(grammarList))
+}
+
+func astForAugmentedAssignment(root *grammar.AugmentedAssignment) Operator {
+	switch root.Children()[0].(*grammar.TokenNode).Token.ID {
+	case token.PLUSEQUAL:
+		return NewAdd()
+	case token.MINEQUAL:
+		return NewSub()
+	case token.STAREQUAL:
+		return NewMult()
+	case token.ATEQUAL:
+		return NewMatMult()
+	case token.SLASHEQUAL:
+		return NewDiv()
+	case token.PERCENTEQUAL:
+		return NewModulo()
+	case token.AMPEREQUAL:
+		return NewBitAnd()
+	case token.VBAREQUAL:
+		return NewBitOr()
+	case token.CIRCUMFLEXEQUAL:
+		return NewBitXor()
+	case token.LEFTSHIFTEQUAL:
+		return NewLShift()
+	case token.RIGHTSHIFTEQUAL:
+		return NewRShift()
+	case token.DOUBLESTAREQUAL:
+		return NewPow()
+	case token.DOUBLESLASHEQUAL:
+		return NewFloorDiv()
 	}
 	return nil
 }
 
-func astForExpressionStatement(root *grammar.ExpressionStatement) Statement {
+func astForFlowStatement(root *grammar.FlowStatement) (Statement, error) {
+	switch stmt := root.Child().(type) {
+	case *grammar.BreakStatement:
+		return statementAt(NewBreak(), root), nil
+	case *grammar.ContinueStatement:
+		return statementAt(NewContinue(), root), nil
+	case *grammar.ReturnStatement:
+		// return_stmt: 'return' [testlist]
+		children := stmt.Children()
+		if len(children) == 1 {
+			return statementAt(NewReturn(nil), root), nil
+		}
+		value, err := astForTestlist(children[1].(grammarList))
+		if err != nil {
+			return nil, err
+		}
+		return statementAt(NewReturn(value), root), nil
+	case *grammar.RaiseStatement:
+		// raise_stmt: 'raise' [test ['from' test]]
+		children := stmt.Children()
+		var exc, cause Expression
+		var err error
+		if len(children) >= 2 {
+			if exc, err = astForExpression(children[1]); err != nil {
+				return nil, err
+			}
+		}
+		if len(children) == 4 {
+			if cause, err = astForExpression(children[3]); err != nil {
+				return nil, err
+			}
+		}
+		return statementAt(NewRaise(exc, cause), root), nil
+	case *grammar.YieldStatement:
+		value, err := astForExpression(stmt.Child())
+		if err != nil {
+			return nil, err
+		}
+		return statementAt(NewExpr(value), root), nil
+	}
+	return nil, unexpectedNode(root.Child())
+}
+
+func astForImportStatement(root *grammar.ImportStatement) (Statement, error) {
+	switch stmt := root.Child().(type) {
+	case *grammar.ImportName:
+		// import_name: 'import' dotted_as_names
+		names, err := aliasesForImport(stmt.Children()[1].(grammarList))
+		if err != nil {
+			return nil, err
+		}
+		return statementAt(NewImport(names), root), nil
+	case *grammar.ImportFrom:
+		// import_from: ('from' (('.' | '...')* dotted_name | ('.' | '...')+)
+		//               'import' ('*' | '(' import_as_names ')' | import_as_names))
+		children := stmt.Children()
+		level := 0
+		var module *gython.Unicode
+		i := 1
+		for ; !isLiteral(children[i], "import"); i++ {
+			switch child := children[i].(type) {
+			case *grammar.DottedName:
+				module = gython.NewUnicode([]byte(dottedName(child)))
+			case *grammar.TokenNode:
+				if child.Token.ID == token.ELLIPSIS {
+					level += 3
+				} else {
+					level++
+				}
+			}
+		}
+
+		var names []*Alias
+		var err error
+		switch child := children[i+1].(type) {
+		case *grammar.ImportAsNames:
+			if child.Length()%2 == 0 {
+				return nil, newSyntaxError(child, "trailing comma not allowed without surrounding parentheses")
+			}
+			names, err = aliasesForImport(child)
+		case *grammar.TokenNode:
+			if child.Token.ID == token.STAR {
+				names = []*Alias{NewAlias("*", nil)}
+			} else {
+				names, err = aliasesForImport(children[i+2].(grammarList))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return statementAt(NewImportFrom(module, names, level), root), nil
+	}
+	return nil, unexpectedNode(root.Child())
+}
+
+// aliasesForImport returns the aliases of a dotted_as_names or import_as_names
+func aliasesForImport(root grammarList) ([]*Alias, error) {
+	aliases := make([]*Alias, 0)
+	children := root.Children()
+	for i := 0; i < len(children); i += 2 {
+		alias, err := aliasForImportName(children[i])
+		if err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, alias)
+	}
+	return aliases, nil
+}
+
+// import_as_name: NAME ['as' NAME]
+// dotted_as_name: dotted_name ['as' NAME]
+func aliasForImportName(root grammar.Node) (*Alias, error) {
+	children := root.(grammarList).Children()
+	if len(children) == 3 {
+		asName := identifier(children[2])
+		if err := forbiddenName(asName.String(), children[2], false); err != nil {
+			return nil, err
+		}
+		switch name := children[0].(type) {
+		case *grammar.DottedName:
+			return NewAlias(dottedName(name), asName), nil
+		default:
+			return NewAlias(tokenLiteral(name), asName), nil
+		}
+	}
+
+	// Without "as" a plain name is bound itself, while only the first part of a dotted name is
+	name := children[0]
+	if dotted, isDotted := name.(*grammar.DottedName); isDotted {
+		if dotted.Length() > 1 {
+			return NewAlias(dottedName(dotted), nil), nil
+		}
+		name = dotted.Children()[0]
+	}
+	if err := forbiddenName(tokenLiteral(name), name, false); err != nil {
+		return nil, err
+	}
+	return NewAlias(tokenLiteral(name), nil), nil
+}
+
+// dottedName joins the names of a dotted_name with '.'
+func dottedName(root *grammar.DottedName) string {
+	names := make([]string, 0)
+	children := root.Children()
+	for i := 0; i < len(children); i += 2 {
+		names = append(names, tokenLiteral(children[i]))
+	}
+	return strings.Join(names, ".")
+}
+
+func astForCompoundStatement(root *grammar.CompoundStatement) (Statement, error) {
+	switch stmt := root.Child().(type) {
+	case *grammar.IfStatement:
+		return astForIfStatement(stmt)
+	case *grammar.WhileStatement:
+		return astForWhileStatement(stmt)
+	case *grammar.ForStatement:
+		return astForForStatement(stmt, false)
+	case *grammar.TryStatement:
+		return astForTryStatement(stmt)
+	case *grammar.WithStatement:
+		return astForWithStatement(stmt, false)
+	case *grammar.FunctionDefinition:
+		return astForFunctionDefinition(stmt, make([]Expression, 0), false)
+	case *grammar.ClassDefinition:
+		return astForClassDefinition(stmt, make([]Expression, 0))
+	case *grammar.Decorated:
+		return astForDecorated(stmt)
+	case *grammar.AsyncStatement:
+		return astForAsyncStatement(stmt)
+	}
+	return nil, unexpectedNode(root.Child())
+}
+
+// suite: simple_stmt | NEWLINE INDENT stmt+ DEDENT
+func astForSuite(root grammar.Node) ([]Statement, error) {
+	children := root.(*grammar.Suite).Children()
+	if simpleStmt, isSimple := children[0].(*grammar.SimpleStatement); isSimple {
+		return astForSimpleStatement(simpleStmt)
+	}
+
+	stmts := make([]Statement, 0)
+	for _, child := range children {
+		if stmt, isStmt := child.(*grammar.Statement); isStmt {
+			lowered, err := astForStatement(stmt)
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, lowered...)
+		}
+	}
+	return stmts, nil
+}
+
+// astForClause lowers the test and suite of an if, elif or while clause
+func astForClause(test grammar.Node, suite grammar.Node) (Expression, []Statement, error) {
+	condition, err := astForExpression(test)
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := astForSuite(suite)
+	if err != nil {
+		return nil, nil, err
+	}
+	return condition, body, nil
+}
+
+// if_stmt: 'if' test ':' suite ('elif' test ':' suite)* ['else' ':' suite]
+func astForIfStatement(root *grammar.IfStatement) (Statement, error) {
+	children := root.Children()
+	end := len(children)
+	orElse := make([]Statement, 0)
+	if isLiteral(children[end-3], "else") {
+		var err error
+		if orElse, err = astForSuite(children[end-1]); err != nil {
+			return nil, err
+		}
+		end -= 3
+	}
+
+	// Each elif becomes an If nested in the orelse of the clause before it
+	for i := end - 4; i > 0; i -= 4 {
+		test, body, err := astForClause(children[i+1], children[i+3])
+		if err != nil {
+			return nil, err
+		}
+		// ast.c places an elif at its test rather than at the keyword
+		orElse = []Statement{statementAt(NewIf(test, body, orElse), children[i+1])}
+	}
+
+	test, body, err := astForClause(children[1], children[3])
+	if err != nil {
+		return nil, err
+	}
+	return statementAt(NewIf(test, body, orElse), root), nil
+}
+
+// while_stmt: 'while' test ':' suite ['else' ':' suite]
+func astForWhileStatement(root *grammar.WhileStatement) (Statement, error) {
+	children := root.Children()
+	test, body, err := astForClause(children[1], children[3])
+	if err != nil {
+		return nil, err
+	}
+	orElse := make([]Statement, 0)
+	if len(children) == 7 {
+		if orElse, err = astForSuite(children[6]); err != nil {
+			return nil, err
+		}
+	}
+	return statementAt(NewWhile(test, body, orElse), root), nil
+}
+
+// for_stmt: 'for' exprlist 'in' testlist ':' suite ['else' ':' suite]
+func astForForStatement(root *grammar.ForStatement, async bool) (Statement, error) {
 	children := root.Children()
+	target, err := astForTarget(children[1].(*grammar.ExpressionList))
+	if err != nil {
+		return nil, err
+	}
+	iter, err := astForTestlist(children[3].(grammarList))
+	if err != nil {
+		return nil, err
+	}
+	body, err := astForSuite(children[5])
+	if err != nil {
+		return nil, err
+	}
+	orElse := make([]Statement, 0)
+	if len(children) == 9 {
+		if orElse, err = astForSuite(children[8]); err != nil {
+			return nil, err
+		}
+	}
+
+	if async {
+		return statementAt(NewAsyncFor(target, iter, body, orElse), root), nil
+	}
+	return statementAt(NewFor(target, iter, body, orElse), root), nil
+}
+
+// astForTarget lowers the exprlist assigned to by a for loop or comprehension
+func astForTarget(root *grammar.ExpressionList) (Expression, error) {
+	children := root.Children()
+	targets := make([]Expression, 0)
+	for i := 0; i < len(children); i += 2 {
+		target, err := astForExpression(children[i])
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, target)
+	}
+	// Check the number of children rather than targets, since `for x, in ...` still assigns to a Tuple
 	if len(children) == 1 {
+		if err := setContext(targets[0], NewStore(), children[0]); err != nil {
+			return nil, err
+		}
+		return targets[0], nil
+	}
+	// Each element is checked at its own node, a starred element is allowed since it's in a Tuple
+	for i, target := range targets {
+		if err := setElementContext(target, NewStore(), children[i*2]); err != nil {
+			return nil, err
+		}
+	}
+	// The Tuple is placed at its first element
+	tuple := NewTuple(targets, NewStore())
+	*tuple.Pos() = *targets[0].Pos()
+	return tuple, nil
+}
 
-	} else if "todo" == "augassign" {
-	} else {
-		if !isToken(children[1], token.EQUAL) {
-			return nil
+// try_stmt: ('try' ':' suite
+//            ((except_clause ':' suite)+
+//             ['else' ':' suite]
+//             ['finally' ':' suite] |
+//            'finally' ':' suite))
+func astForTryStatement(root *grammar.TryStatement) (Statement, error) {
+	children := root.Children()
+	body, err := astForSuite(children[2])
+	if err != nil {
+		return nil, err
+	}
+	handlers := make([]*ExceptHandler, 0)
+	orElse := make([]Statement, 0)
+	finalBody := make([]Statement, 0)
+	for i := 3; i < len(children); i += 3 {
+		switch child := children[i].(type) {
+		case *grammar.ExceptClause:
+			handler, err := astForExceptClause(child, children[i+2])
+			if err != nil {
+				return nil, err
+			}
+			handlers = append(handlers, handler)
+		case *grammar.TokenNode:
+			if child.Token.IsLiteral("else") {
+				orElse, err = astForSuite(children[i+2])
+			} else {
+				finalBody, err = astForSuite(children[i+2])
+			}
+			if err != nil {
+				return nil, err
+			}
 		}
-		length := len(children)
-		var value Expression
-		switch child := children[length-1].(type) {
-		case *grammar.TestlistStarExpression:
-			value = astForTestList(child)
+	}
+	return statementAt(NewTry(body, handlers, orElse, finalBody), root), nil
+}
+
+// except_clause: 'except' [test ['as' NAME]]
+func astForExceptClause(root *grammar.ExceptClause, suite grammar.Node) (*ExceptHandler, error) {
+	children := root.Children()
+	var typ Expression
+	var name *gython.Unicode
+	var err error
+	if len(children) >= 2 {
+		if typ, err = astForExpression(children[1]); err != nil {
+			return nil, err
+		}
+	}
+	if len(children) == 4 {
+		name = identifier(children[3])
+		if err := forbiddenName(name.String(), children[3], false); err != nil {
+			return nil, err
+		}
+	}
+	body, err := astForSuite(suite)
+	if err != nil {
+		return nil, err
+	}
+	handler := NewExceptHandler(typ, name, body)
+	setPosition(handler, root)
+	return handler, nil
+}
+
+// with_stmt: 'with' with_item (',' with_item)*  ':' suite
+func astForWithStatement(root *grammar.WithStatement, async bool) (Statement, error) {
+	children := root.Children()
+	items := make([]*WithItem, 0)
+	for _, child := range children {
+		if item, isItem := child.(*grammar.WithItem); isItem {
+			withItem, err := astForWithItem(item)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, withItem)
+		}
+	}
+	body, err := astForSuite(children[len(children)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	if async {
+		return statementAt(NewAsyncWith(items, body), root), nil
+	}
+	return statementAt(NewWith(items, body), root), nil
+}
+
+// with_item: test ['as' expr]
+func astForWithItem(root *grammar.WithItem) (*WithItem, error) {
+	children := root.Children()
+	contextExpr, err := astForExpression(children[0])
+	if err != nil {
+		return nil, err
+	}
+	var optionalVars Expression
+	if len(children) == 3 {
+		if optionalVars, err = astForExpression(children[2]); err != nil {
+			return nil, err
+		}
+		if err := setContext(optionalVars, NewStore(), root); err != nil {
+			return nil, err
+		}
+	}
+	return NewWithItem(contextExpr, optionalVars), nil
+}
+
+// funcdef: 'def' NAME parameters ['->' test] ':' suite
+func astForFunctionDefinition(root *grammar.FunctionDefinition, decorators []Expression, async bool) (Statement, error) {
+	children := root.Children()
+	name := tokenLiteral(children[1])
+	if err := forbiddenName(name, children[1], false); err != nil {
+		return nil, err
+	}
+	args, err := astForArguments(children[2])
+	if err != nil {
+		return nil, err
+	}
+	var returns Expression
+	if isToken(children[3], token.RARROW) {
+		if returns, err = astForExpression(children[4]); err != nil {
+			return nil, err
+		}
+	}
+	body, err := astForSuite(children[len(children)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	if async {
+		return statementAt(NewAsyncFunctionDef(name, args, body, decorators, returns), root), nil
+	}
+	return statementAt(NewFunctionDef(name, args, body, decorators, returns), root), nil
+}
+
+// classdef: 'class' NAME ['(' [arglist] ')'] ':' suite
+func astForClassDefinition(root *grammar.ClassDefinition, decorators []Expression) (Statement, error) {
+	children := root.Children()
+	name := tokenLiteral(children[1])
+
+	// ast.c reports a forbidden class name at the suite or ')' unless there are bases
+	nameNode := children[3]
+	if len(children) == 7 {
+		nameNode = children[1]
+	}
+	if err := forbiddenName(name, nameNode, false); err != nil {
+		return nil, err
+	}
+
+	bases := make([]Expression, 0)
+	keywords := make([]*Keyword, 0)
+	if len(children) == 7 {
+		// The arguments are parsed like a call of the class name
+		call, err := astForCall(children[3].(*grammar.ArgumentList), NewName(name, NewLoad()))
+		if err != nil {
+			return nil, err
+		}
+		bases = call.Args
+		keywords = call.Keywords
+	}
+
+	body, err := astForSuite(children[len(children)-1])
+	if err != nil {
+		return nil, err
+	}
+	return statementAt(NewClassDef(name, bases, keywords, body, decorators), root), nil
+}
+
+// decorated: decorators (classdef | funcdef | async_funcdef)
+func astForDecorated(root *grammar.Decorated) (Statement, error) {
+	children := root.Children()
+	decorators := make([]Expression, 0)
+	for _, decorator := range children[0].(*grammar.Decorators).Children() {
+		expr, err := astForDecorator(decorator.(*grammar.Decorator))
+		if err != nil {
+			return nil, err
+		}
+		decorators = append(decorators, expr)
+	}
+
+	var def Statement
+	var err error
+	switch child := children[1].(type) {
+	case *grammar.FunctionDefinition:
+		def, err = astForFunctionDefinition(child, decorators, false)
+	case *grammar.AsyncFunctionDefinition:
+		def, err = astForFunctionDefinition(child.Children()[1].(*grammar.FunctionDefinition), decorators, true)
+	case *grammar.ClassDefinition:
+		def, err = astForClassDefinition(child, decorators)
+	default:
+		return nil, unexpectedNode(child)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// A decorated definition is placed at its first decorator
+	return statementAt(def, root), nil
+}
+
+// decorator: '@' dotted_name [ '(' [arglist] ')' ] NEWLINE
+func astForDecorator(root *grammar.Decorator) (Expression, error) {
+	children := root.Children()
+	// Every part of the dotted name is placed at its start
+	names := children[1].(*grammar.DottedName).Children()
+	name := expressionAt(NewName(tokenLiteral(names[0]), NewLoad()), children[1])
+	for i := 2; i < len(names); i += 2 {
+		name = expressionAt(NewAttribute(name, tokenLiteral(names[i]), NewLoad()), children[1])
+	}
+
+	switch len(children) {
+	case 3:
+		return name, nil
+	case 5:
+		return expressionAt(NewCall(name, make([]Expression, 0), make([]*Keyword, 0)), root), nil
+	}
+	return astForCall(children[3].(*grammar.ArgumentList), name)
+}
+
+// async_stmt: ASYNC (funcdef | with_stmt | for_stmt)
+func astForAsyncStatement(root *grammar.AsyncStatement) (Statement, error) {
+	switch stmt := root.Children()[1].(type) {
+	case *grammar.FunctionDefinition:
+		return astForFunctionDefinition(stmt, make([]Expression, 0), true)
+	case *grammar.WithStatement:
+		return astForWithStatement(stmt, true)
+	case *grammar.ForStatement:
+		return astForForStatement(stmt, true)
+	}
+	return nil, unexpectedNode(root.Children()[1])
+}
+
+// astForArguments lowers the parameters of a funcdef or the varargslist of a lambdef
+func astForArguments(root grammar.Node) (*Arguments, error) {
+	args := NewArguments()
+	if parameters, isParameters := root.(*grammar.Parameters); isParameters {
+		// parameters: '(' [typedargslist] ')'
+		if parameters.Length() == 2 {
+			return args, nil
+		}
+		root = parameters.Children()[1]
+	}
+
+	children := root.(grammarList).Children()
+	foundDefault := false
+	for i := 0; i < len(children); {
+		if isParameter(children[i]) {
+			param := children[i]
+			if i+1 < len(children) && isToken(children[i+1], token.EQUAL) {
+				value, err := astForExpression(children[i+2])
+				if err != nil {
+					return nil, err
+				}
+				args.Defaults = append(args.Defaults, value)
+				foundDefault = true
+				// the '=' and the default
+				i += 2
+			} else if foundDefault {
+				return nil, newSyntaxError(root, "non-default argument follows default argument")
+			}
+			arg, err := astForArg(param)
+			if err != nil {
+				return nil, err
+			}
+			args.Args = append(args.Args, arg)
+			// the name and the comma
+			i += 2
+			continue
+		}
+
+		var err error
+		switch {
+		case isToken(children[i], token.STAR):
+			if i+1 >= len(children) {
+				return nil, newSyntaxError(children[i], "named arguments must follow bare *")
+			}
+			if isToken(children[i+1], token.COMMA) {
+				// A bare '*' must be followed by keyword only arguments, with only a trailing comma
+				// after it the error is placed on the '*' like in ast.c
+				if i+2 >= len(children) {
+					return nil, newSyntaxError(children[i], "named arguments must follow bare *")
+				}
+				if !isParameter(children[i+2]) {
+					return nil, newSyntaxError(children[i+2], "named arguments must follow bare *")
+				}
+				i, err = handleKeywordOnlyArguments(children, i+2, args)
+			} else {
+				if args.VarArg, err = astForArg(children[i+1]); err != nil {
+					return nil, err
+				}
+				i += 3
+				if i < len(children) && isParameter(children[i]) {
+					i, err = handleKeywordOnlyArguments(children, i, args)
+				}
+			}
+		case isToken(children[i], token.DOUBLESTAR):
+			args.KwArg, err = astForArg(children[i+1])
+			i += 3
 		default:
-			value = nil
+			return nil, unexpectedNode(children[i])
+		}
+		if err != nil {
+			return nil, err
 		}
+	}
+	return args, nil
+}
 
-		assign := NewAssign(value)
-		for i := 0; i < length-2; i++ {
-			target := astForTestList(children[i].(grammar.ExpressionStatementChild))
-			switch target := target.(type) {
-			case *Name:
-				target.Context = NewStore()
+// handleKeywordOnlyArguments adds the parameters from start up to a '**' as keyword only arguments, it returns the index after them
+func handleKeywordOnlyArguments(children []grammar.Node, start int, args *Arguments) (int, error) {
+	i := start
+	for i < len(children) && isParameter(children[i]) {
+		var value Expression
+		arg, err := astForArg(children[i])
+		if err != nil {
+			return 0, err
+		}
+		if i+1 < len(children) && isToken(children[i+1], token.EQUAL) {
+			if value, err = astForExpression(children[i+2]); err != nil {
+				return 0, err
 			}
-			assign.Append(target)
+			i += 4
+		} else {
+			i += 2
 		}
-		return assign
+		args.KwOnlyArgs = append(args.KwOnlyArgs, arg)
+		args.KwDefaults = append(args.KwDefaults, value)
 	}
-	return nil
+	return i, nil
 }
 
-func astForTestList(root grammar.ExpressionStatementChild) Expression {
-	switch root := root.(type) {
-	case *grammar.TestlistStarExpression:
-		if root.Length() == 1 {
-			return astForExpression(root.Children()[0])
+func isParameter(node grammar.Node) bool {
+	switch node.(type) {
+	case *grammar.TypedFunctionParameter, *grammar.VarFunctionParameter:
+		return true
+	}
+	return false
+}
+
+// tfpdef: NAME [':' test]
+// vfpdef: NAME
+func astForArg(root grammar.Node) (*Arg, error) {
+	children := root.(grammarList).Children()
+	name := tokenLiteral(children[0])
+	if err := forbiddenName(name, root, false); err != nil {
+		return nil, err
+	}
+	var annotation Expression
+	if len(children) == 3 {
+		var err error
+		if annotation, err = astForExpression(children[2]); err != nil {
+			return nil, err
 		}
 	}
-	return nil
+	arg := NewArg(name, annotation)
+	setPosition(arg, root)
+	return arg, nil
+}
+
+// astForTestlist lowers a testlist, testlist_star_expr, exprlist or testlist_comp into a single expression or a Tuple
+func astForTestlist(root grammarList) (Expression, error) {
+	children := root.Children()
+	if len(children) == 1 {
+		return astForExpression(children[0])
+	}
+	elements, err := seqForTestlist(root)
+	if err != nil {
+		return nil, err
+	}
+	return expressionAt(NewTuple(elements, NewLoad()), root), nil
 }
 
-func astForExpression(root grammar.Node) Expression {
+// seqForTestlist returns the expressions of a comma separated list
+func seqForTestlist(root grammarList) ([]Expression, error) {
+	exprs := make([]Expression, 0)
+	children := root.Children()
+	for i := 0; i < len(children); i += 2 {
+		expr, err := astForExpression(children[i])
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	return exprs, nil
+}
+
+func astForExpression(root grammar.Node) (Expression, error) {
 	switch root := root.(type) {
 	case *grammar.Test:
-		if root.Length() == 1 {
-			return astForExpression(root.Children()[0])
+		// test: or_test ['if' or_test 'else' test] | lambdef
+		children := root.Children()
+		if len(children) == 1 {
+			return astForExpression(children[0])
 		}
+		body, err := astForExpression(children[0])
+		if err != nil {
+			return nil, err
+		}
+		test, err := astForExpression(children[2])
+		if err != nil {
+			return nil, err
+		}
+		orElse, err := astForExpression(children[4])
+		if err != nil {
+			return nil, err
+		}
+		return expressionAt(NewIfExp(test, body, orElse), root), nil
+	case *grammar.TestNocond:
+		return astForExpression(root.Child())
+	case *grammar.LambdaDefinition, *grammar.LambdaDefinitionNocond:
+		// lambdef: 'lambda' [varargslist] ':' test
+		children := root.(grammarList).Children()
+		args := NewArguments()
+		if len(children) == 4 {
+			var err error
+			if args, err = astForArguments(children[1]); err != nil {
+				return nil, err
+			}
+		}
+		body, err := astForExpression(children[len(children)-1])
+		if err != nil {
+			return nil, err
+		}
+		return expressionAt(NewLambda(args, body), root), nil
 	case *grammar.OrTest:
 		if root.Length() == 1 {
 			return astForExpression(root.Children()[0])
 		}
+		values, err := seqForTestlist(root)
+		if err != nil {
+			return nil, err
+		}
+		return expressionAt(NewBoolOp(NewOr(), values), root), nil
 	case *grammar.AndTest:
 		if root.Length() == 1 {
 			return astForExpression(root.Children()[0])
 		}
+		values, err := seqForTestlist(root)
+		if err != nil {
+			return nil, err
+		}
+		return expressionAt(NewBoolOp(NewAnd(), values), root), nil
 	case *grammar.NotTest:
-		if root.Length() == 1 {
-			return astForExpression(root.Children()[0])
+		// not_test: 'not' not_test | comparison
+		children := root.Children()
+		if len(children) == 1 {
+			return astForExpression(children[0])
+		}
+		operand, err := astForExpression(children[1])
+		if err != nil {
+			return nil, err
 		}
+		return expressionAt(NewUnaryOp(NewNot(), operand), root), nil
 	case *grammar.Comparison:
-		if root.Length() == 1 {
-			return astForExpression(root.Children()[0])
+		children := root.Children()
+		if len(children) == 1 {
+			return astForExpression(children[0])
 		}
-	case *grammar.Expression:
-		if root.Length() == 1 {
-			return astForExpression(root.Children()[0])
+		left, err := astForExpression(children[0])
+		if err != nil {
+			return nil, err
 		}
-	case *grammar.XorExpression:
-		if root.Length() == 1 {
-			return astForExpression(root.Children()[0])
+		ops := make([]CompareOperator, 0)
+		comparators := make([]Expression, 0)
+		for i := 1; i < len(children); i += 2 {
+			comparator, err := astForExpression(children[i+1])
+			if err != nil {
+				return nil, err
+			}
+			ops = append(ops, astForCompareOperator(children[i].(*grammar.CompareOperator)))
+			comparators = append(comparators, comparator)
 		}
-	case *grammar.AndExpression:
-		if root.Length() == 1 {
-			return astForExpression(root.Children()[0])
+		return expressionAt(NewCompare(left, ops, comparators), root), nil
+	case *grammar.StarExpression:
+		// star_expr: '*' expr
+		value, err := astForExpression(root.Children()[1])
+		if err != nil {
+			return nil, err
 		}
-	case *grammar.ShiftExpression:
-		if root.Length() == 1 {
-			return astForExpression(root.Children()[0])
+		return expressionAt(NewStarred(value, NewLoad()), root), nil
+	case *grammar.Expression, *grammar.XorExpression, *grammar.AndExpression,
+		*grammar.ShiftExpression, *grammar.ArithmeticExpression, *grammar.Term:
+		children := root.(grammarList).Children()
+		if len(children) == 1 {
+			return astForExpression(children[0])
 		}
-	case *grammar.ArithmeticExpression:
-		if root.Length() == 1 {
-			return astForExpression(root.Children()[0])
+		return astForBinOp(children)
+	case *grammar.Factor:
+		// factor: ('+'|'-'|'~') factor | power
+		children := root.Children()
+		if len(children) == 1 {
+			return astForExpression(children[0])
 		}
-	case *grammar.Term:
-		if root.Length() == 1 {
-			return astForExpression(root.Children()[0])
+		operand, err := astForExpression(children[1])
+		if err != nil {
+			return nil, err
 		}
-	case *grammar.Factor:
-		if root.Length() == 1 {
-			return astForExpression(root.Children()[0])
+		var op UnaryOperator
+		switch children[0].(*grammar.TokenNode).Token.ID {
+		case token.PLUS:
+			op = NewUAdd()
+		case token.MINUS:
+			op = NewUSub()
+		case token.TILDE:
+			op = NewInvert()
 		}
+		return expressionAt(NewUnaryOp(op, operand), root), nil
 	case *grammar.Power:
 		return astForPower(root)
-	default:
-		fmt.Println(symbol.SymbolNames[root.ID()])
+	case *grammar.YieldExpression:
+		return astForYieldExpression(root)
+	}
+	return nil, unexpectedNode(root)
+}
+
+// astForBinOp lowers a chain of left associative binary operators. As in
+// ast.c the first operation is placed at its left operand and every later
+// one at its operator.
+func astForBinOp(children []grammar.Node) (Expression, error) {
+	left, err := astForExpression(children[0])
+	if err != nil {
+		return nil, err
+	}
+	right, err := astForExpression(children[2])
+	if err != nil {
+		return nil, err
+	}
+	result := expressionAt(NewBinOp(left, getOperator(children[1]), right), children[0])
+	for i := 3; i < len(children); i += 2 {
+		right, err := astForExpression(children[i+1])
+		if err != nil {
+			return nil, err
+		}
+		result = expressionAt(NewBinOp(result, getOperator(children[i]), right), children[i])
+	}
+	return result, nil
+}
+
+func getOperator(root grammar.Node) Operator {
+	switch root.(*grammar.TokenNode).Token.ID {
+	case token.VBAR:
+		return NewBitOr()
+	case token.CIRCUMFLEX:
+		return NewBitXor()
+	case token.AMPER:
+		return NewBitAnd()
+	case token.LEFTSHIFT:
+		return NewLShift()
+	case token.RIGHTSHIFT:
+		return NewRShift()
+	case token.PLUS:
+		return NewAdd()
+	case token.MINUS:
+		return NewSub()
+	case token.STAR:
+		return NewMult()
+	case token.AT:
+		return NewMatMult()
+	case token.SLASH:
+		return NewDiv()
+	case token.DOUBLESLASH:
+		return NewFloorDiv()
+	case token.PERCENT:
+		return NewModulo()
 	}
 	return nil
 }
 
-func astForPower(root *grammar.Power) Expression {
+// comp_op: '<'|'>'|'=='|'>='|'<='|'<>'|'!='|'in'|'not' 'in'|'is'|'is' 'not'
+func astForCompareOperator(root *grammar.CompareOperator) CompareOperator {
 	children := root.Children()
-	var expr Expression
-	if child, isAtomExpr := children[0].(*grammar.AtomExpression); isAtomExpr {
-		expr = astForAtomExpression(child)
-	} else {
-		return nil
+	if len(children) == 2 {
+		if isLiteral(children[0], "not") {
+			return NewNotIn()
+		}
+		return NewIsNot()
 	}
 
-	if len(children) == 1 {
-		return expr
+	tok := children[0].(*grammar.TokenNode).Token
+	switch tok.ID {
+	case token.LESS:
+		return NewLt()
+	case token.GREATER:
+		return NewGt()
+	case token.EQEQUAL:
+		return NewEq()
+	case token.LESSEQUAL:
+		return NewLtE()
+	case token.GREATEREQUAL:
+		return NewGtE()
+	case token.NOTEQUAL:
+		return NewNotEq()
+	case token.NAME:
+		if tok.Literal == "in" {
+			return NewIn()
+		}
+		return NewIs()
 	}
-
 	return nil
 }
 
-func astForAtomExpression(root *grammar.AtomExpression) Expression {
+// power: atom_expr ['**' factor]
+func astForPower(root *grammar.Power) (Expression, error) {
 	children := root.Children()
-	switch child := children[0].(type) {
-	case *grammar.Atom:
-		return astForAtom(child)
+	expr, err := astForAtomExpression(children[0].(*grammar.AtomExpression))
+	if err != nil || len(children) == 1 {
+		return expr, err
 	}
-	return nil
+	exponent, err := astForExpression(children[2])
+	if err != nil {
+		return nil, err
+	}
+	return expressionAt(NewBinOp(expr, NewPow(), exponent), root), nil
 }
 
-func astForAtom(root *grammar.Atom) Expression {
+// atom_expr: [AWAIT] atom trailer*
+func astForAtomExpression(root *grammar.AtomExpression) (Expression, error) {
 	children := root.Children()
-	if len(children) == 1 {
-		switch child := children[0].(type) {
-		case *grammar.TokenNode:
-			switch child.Token.ID {
-			case token.NAME:
-				// TODO: Check for "None", "True", and "False"
-				return NewName(child.Token.Literal, NewLoad())
-			case token.NUMBER:
-				value, err := strconv.ParseInt(child.Token.Literal, 10, 64)
+	await := isToken(children[0], token.AWAIT)
+	if await {
+		children = children[1:]
+	}
+
+	// Calls, attributes and subscripts all take the position of the atom's
+	// expression, which for a parenthesized atom is inside the '('
+	expr, err := astForAtom(children[0].(*grammar.Atom))
+	if err != nil {
+		return nil, err
+	}
+	for _, trailer := range children[1:] {
+		result, err := astForTrailer(trailer.(*grammar.Trailer), expr)
+		if err != nil {
+			return nil, err
+		}
+		*result.Pos() = *expr.Pos()
+		expr = result
+	}
+
+	if await {
+		return expressionAt(NewAwait(expr), root), nil
+	}
+	return expr, nil
+}
+
+// trailer: '(' [arglist] ')' | '[' subscriptlist ']' | '.' NAME
+func astForTrailer(root *grammar.Trailer, left Expression) (Expression, error) {
+	children := root.Children()
+	switch children[0].(*grammar.TokenNode).Token.ID {
+	case token.LPAR:
+		if len(children) == 2 {
+			return NewCall(left, make([]Expression, 0), make([]*Keyword, 0)), nil
+		}
+		return astForCall(children[1].(*grammar.ArgumentList), left)
+	case token.DOT:
+		return NewAttribute(left, tokenLiteral(children[1]), NewLoad()), nil
+	}
+
+	subscripts := children[1].(*grammar.SubscriptList).Children()
+	if len(subscripts) == 1 {
+		slice, err := astForSlice(subscripts[0].(*grammar.Subscript))
+		if err != nil {
+			return nil, err
+		}
+		return NewSubscript(left, slice, NewLoad()), nil
+	}
+
+	// x[a, b:c] is an ExtSlice while x[a, b] indexes with a Tuple
+	simple := true
+	slices := make([]SliceNode, 0)
+	for i := 0; i < len(subscripts); i += 2 {
+		slice, err := astForSlice(subscripts[i].(*grammar.Subscript))
+		if err != nil {
+			return nil, err
+		}
+		if _, isIndex := slice.(*Index); !isIndex {
+			simple = false
+		}
+		slices = append(slices, slice)
+	}
+	if !simple {
+		return NewSubscript(left, NewExtSlice(slices), NewLoad()), nil
+	}
+
+	elements := make([]Expression, 0, len(slices))
+	for _, slice := range slices {
+		elements = append(elements, slice.(*Index).Value)
+	}
+	index := expressionAt(NewTuple(elements, NewLoad()), children[1])
+	return NewSubscript(left, NewIndex(index), NewLoad()), nil
+}
+
+// subscript: test | [test] ':' [test] [sliceop]
+func astForSlice(root *grammar.Subscript) (SliceNode, error) {
+	children := root.Children()
+	if _, isTest := children[0].(*grammar.Test); isTest && len(children) == 1 {
+		value, err := astForExpression(children[0])
+		if err != nil {
+			return nil, err
+		}
+		return NewIndex(value), nil
+	}
+
+	var lower, upper, step Expression
+	var err error
+	if _, isTest := children[0].(*grammar.Test); isTest {
+		if lower, err = astForExpression(children[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	// If there's an upper bound it's in the second or third position
+	upperIndex := 2
+	if isToken(children[0], token.COLON) {
+		upperIndex = 1
+	}
+	if upperIndex < len(children) {
+		if test, isTest := children[upperIndex].(*grammar.Test); isTest {
+			if upper, err = astForExpression(test); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// sliceop: ':' [test]
+	if sliceOp, isSliceOp := children[len(children)-1].(*grammar.SliceOperation); isSliceOp && sliceOp.Length() == 2 {
+		if step, err = astForExpression(sliceOp.Children()[1]); err != nil {
+			return nil, err
+		}
+	}
+	return NewSlice(lower, upper, step), nil
+}
+
+// arglist: argument (',' argument)*  [',']
+// argument: ( test [comp_for] |
+//             test '=' test |
+//             '**' test |
+//             '*' test )
+func astForCall(root *grammar.ArgumentList, function Expression) (*Call, error) {
+	children := root.Children()
+	nargs, nkeywords, ngens := 0, 0, 0
+	for i := 0; i < len(children); i += 2 {
+		parts := children[i].(*grammar.Argument).Children()
+		switch {
+		case len(parts) == 1:
+			nargs++
+		case isComprehensionFor(parts[1]):
+			ngens++
+		default:
+			nkeywords++
+		}
+	}
+	if ngens > 1 || (ngens > 0 && (nargs > 0 || nkeywords > 0)) {
+		return nil, newSyntaxError(root, "Generator expression must be parenthesized if not sole argument")
+	}
+	if nargs+nkeywords+ngens > 255 {
+		return nil, newSyntaxError(root, "more than 255 arguments")
+	}
+
+	args := make([]Expression, 0)
+	keywords := make([]*Keyword, 0)
+	doubleStars := 0
+	for i := 0; i < len(children); i += 2 {
+		parts := children[i].(*grammar.Argument).Children()
+		switch {
+		case len(parts) == 1:
+			if len(keywords) > 0 {
+				if doubleStars > 0 {
+					return nil, newSyntaxError(parts[0], "positional argument follows keyword argument unpacking")
+				}
+				return nil, newSyntaxError(parts[0], "positional argument follows keyword argument")
+			}
+			arg, err := astForExpression(parts[0])
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		case isToken(parts[0], token.STAR):
+			if doubleStars > 0 {
+				return nil, newSyntaxError(parts[0], "iterable argument unpacking follows keyword argument unpacking")
+			}
+			value, err := astForExpression(parts[1])
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, expressionAt(NewStarred(value, NewLoad()), parts[0]))
+		case isToken(parts[0], token.DOUBLESTAR):
+			value, err := astForExpression(parts[1])
+			if err != nil {
+				return nil, err
+			}
+			doubleStars++
+			keywords = append(keywords, NewKeyword(nil, value))
+		case isComprehensionFor(parts[1]):
+			// test comp_for
+			generator, err := astForGeneratorExpression(parts[0], parts[1].(*grammar.ComprehensionFor))
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, expressionAt(generator, parts[0]))
+		default:
+			// test '=' test
+			keyword, err := astForKeyword(parts, keywords)
+			if err != nil {
+				return nil, err
+			}
+			keywords = append(keywords, keyword)
+		}
+	}
+
+	call := NewCall(function, args, keywords)
+	*call.Pos() = *function.Pos()
+	return call, nil
+}
+
+// astForKeyword lowers the parts of a keyword argument, test '=' test, checking it against the keywords before it
+func astForKeyword(parts []grammar.Node, keywords []*Keyword) (*Keyword, error) {
+	key, err := astForExpression(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	switch key.(type) {
+	case *Name:
+	case *Lambda:
+		return nil, newSyntaxError(parts[0], "lambda cannot contain assignment")
+	default:
+		return nil, newSyntaxError(parts[0], "keyword can't be an expression")
+	}
+
+	name := key.(*Name).Identifier
+	if err := forbiddenName(name.String(), parts[0], true); err != nil {
+		return nil, err
+	}
+	for _, keyword := range keywords {
+		if keyword.Arg != nil && keyword.Arg.String() == name.String() {
+			return nil, newSyntaxError(parts[0], "keyword argument repeated")
+		}
+	}
+
+	value, err := astForExpression(parts[2])
+	if err != nil {
+		return nil, err
+	}
+	return NewKeyword(name, value), nil
+}
+
+// atom: ('(' [yield_expr|testlist_comp] ')' |
+//        '[' [testlist_comp] ']' |
+//        '{' [dictorsetmaker] '}' |
+//        NAME | NUMBER | STRING+ | '...' | 'None' | 'True' | 'False')
+func astForAtom(root *grammar.Atom) (Expression, error) {
+	children := root.Children()
+	tok := children[0].(*grammar.TokenNode).Token
+	switch tok.ID {
+	case token.NAME:
+		switch tok.Literal {
+		case "None":
+			return expressionAt(NewNameConstant(gython.None), root), nil
+		case "True":
+			return expressionAt(NewNameConstant(gython.True), root), nil
+		case "False":
+			return expressionAt(NewNameConstant(gython.False), root), nil
+		}
+		return expressionAt(NewName(tok.Literal, NewLoad()), root), nil
+	case token.STRING:
+		str, err := astForStrings(root)
+		if err != nil {
+			return nil, err
+		}
+		return expressionAt(str, root), nil
+	case token.NUMBER:
+		value, err := ParseNumber(tok.Literal)
+		if err != nil {
+			return nil, newSyntaxError(root, err.Error())
+		}
+		return expressionAt(NewNum(value), root), nil
+	case token.ELLIPSIS:
+		return expressionAt(NewEllipsis(), root), nil
+	case token.LPAR:
+		if len(children) == 2 {
+			return expressionAt(NewTuple(make([]Expression, 0), NewLoad()), root), nil
+		}
+		// A parenthesized expression keeps its own position, without the '('
+		switch child := children[1].(type) {
+		case *grammar.YieldExpression:
+			return astForExpression(child)
+		case *grammar.TestlistComprehension:
+			if isComprehension(child) {
+				return astForGeneratorExpression(child.Children()[0], child.Children()[1].(*grammar.ComprehensionFor))
+			}
+			return astForTestlist(child)
+		}
+	case token.LSQB:
+		if len(children) == 2 {
+			return expressionAt(NewList(make([]Expression, 0), NewLoad()), root), nil
+		}
+		child := children[1].(*grammar.TestlistComprehension)
+		if isComprehension(child) {
+			element, generators, err := astForComprehensionParts(child.Children()[0], child.Children()[1].(*grammar.ComprehensionFor))
+			if err != nil {
+				return nil, err
+			}
+			return expressionAt(NewListComp(element, generators), child), nil
+		}
+		elements, err := seqForTestlist(child)
+		if err != nil {
+			return nil, err
+		}
+		return expressionAt(NewList(elements, NewLoad()), root), nil
+	case token.LBRACE:
+		if len(children) == 2 {
+			return expressionAt(NewDict(make([]Expression, 0), make([]Expression, 0)), root), nil
+		}
+		expr, err := astForDictOrSetMaker(children[1].(*grammar.DictOrSetMaker), root)
+		if err != nil {
+			return nil, err
+		}
+		return expressionAt(expr, root), nil
+	}
+	return nil, unexpectedNode(children[0])
+}
+
+// isComprehension reports whether a testlist_comp is an element followed by a comp_for
+func isComprehension(root *grammar.TestlistComprehension) bool {
+	children := root.Children()
+	return len(children) >= 2 && isComprehensionFor(children[1])
+}
+
+func isComprehensionFor(node grammar.Node) bool {
+	_, isCompFor := node.(*grammar.ComprehensionFor)
+	return isCompFor
+}
+
+// astForStrings decodes and concatenates the STRING tokens of an atom
+func astForStrings(root *grammar.Atom) (Expression, error) {
+	isBytes := false
+	value := make([]byte, 0)
+	for i, child := range root.Children() {
+		if isFormattedString(tokenLiteral(child)) {
+			return nil, newSyntaxError(child, "f-strings are not supported by the Python 3.5 AST")
+		}
+		part, partIsBytes, err := ParseString(tokenLiteral(child))
+		if err != nil {
+			return nil, stringError(root, err)
+		}
+		if i > 0 && partIsBytes != isBytes {
+			return nil, newSyntaxError(root, "cannot mix bytes and nonbytes literals")
+		}
+		isBytes = partIsBytes
+		value = append(value, part...)
+	}
+
+	if isBytes {
+		return NewBytes(gython.NewBytesFrom(value)), nil
+	}
+	return NewStr(gython.NewUnicode(value)), nil
+}
+
+// stringError reports a literal which could not be decoded, prefixed with the kind of error like ast_for_atom does
+func stringError(root *grammar.Atom, err error) *SyntaxError {
+	if syntaxErr, isSyntaxErr := err.(*SyntaxError); isSyntaxErr {
+		return newSyntaxError(root, syntaxErr.Message)
+	}
+	kind := "value error"
+	if _, isUnicode := err.(*UnicodeDecodeError); isUnicode {
+		kind = "unicode error"
+	}
+	message := fmt.Sprintf("(%s) %s", kind, err)
+	// ast.c formats the message into a 128 byte buffer
+	if len(message) > 127 {
+		message = message[:127]
+	}
+	return newSyntaxError(root, message)
+}
+
+// dictorsetmaker: ( ((test ':' test | '**' expr)
+//                    (comp_for | (',' (test ':' test | '**' expr))* [','])) |
+//                   ((test | star_expr)
+//                    (comp_for | (',' (test | star_expr))* [','])) )
+func astForDictOrSetMaker(root *grammar.DictOrSetMaker, atom *grammar.Atom) (Expression, error) {
+	children := root.Children()
+	if len(children) == 1 || isToken(children[1], token.COMMA) {
+		elements, err := seqForTestlist(root)
+		if err != nil {
+			return nil, err
+		}
+		return NewSet(elements), nil
+	}
+	if compFor, isCompFor := children[1].(*grammar.ComprehensionFor); isCompFor {
+		element, generators, err := astForComprehensionParts(children[0], compFor)
+		if err != nil {
+			return nil, err
+		}
+		return NewSetComp(element, generators), nil
+	}
+
+	isDict := isToken(children[0], token.DOUBLESTAR)
+	if isDict && len(children) > 2 && isComprehensionFor(children[2]) {
+		return nil, newSyntaxError(atom, "dict unpacking cannot be used in dict comprehension")
+	}
+	if len(children) > 3 {
+		if compFor, isCompFor := children[3].(*grammar.ComprehensionFor); isCompFor {
+			key, err := astForExpression(children[0])
+			if err != nil {
+				return nil, err
+			}
+			value, err := astForExpression(children[2])
+			if err != nil {
+				return nil, err
+			}
+			generators, err := astForComprehension(compFor)
+			if err != nil {
+				return nil, err
+			}
+			return NewDictComp(key, value, generators), nil
+		}
+	}
+
+	keys := make([]Expression, 0)
+	values := make([]Expression, 0)
+	for i := 0; i < len(children); i++ {
+		if isToken(children[i], token.DOUBLESTAR) {
+			// {**d} unpacks d and has no key
+			value, err := astForExpression(children[i+1])
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, nil)
+			values = append(values, value)
+			i += 2
+		} else {
+			key, err := astForExpression(children[i])
+			if err != nil {
+				return nil, err
+			}
+			value, err := astForExpression(children[i+2])
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, key)
+			values = append(values, value)
+			i += 3
+		}
+	}
+	return NewDict(keys, values), nil
+}
+
+// astForGeneratorExpression lowers the element and comp_for of a generator expression
+func astForGeneratorExpression(element grammar.Node, compFor *grammar.ComprehensionFor) (Expression, error) {
+	elt, generators, err := astForComprehensionParts(element, compFor)
+	if err != nil {
+		return nil, err
+	}
+	return expressionAt(NewGeneratorExp(elt, generators), element), nil
+}
+
+// astForComprehensionParts lowers the element and generators of a list, set or generator comprehension
+func astForComprehensionParts(element grammar.Node, compFor *grammar.ComprehensionFor) (Expression, []*Comprehension, error) {
+	elt, err := astForExpression(element)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, isStarred := elt.(*Starred); isStarred {
+		return nil, nil, newSyntaxError(element, "iterable unpacking cannot be used in comprehension")
+	}
+	generators, err := astForComprehension(compFor)
+	if err != nil {
+		return nil, nil, err
+	}
+	return elt, generators, nil
+}
+
+// comp_iter: comp_for | comp_if
+// comp_for: 'for' exprlist 'in' or_test [comp_iter]
+// comp_if: 'if' test_nocond [comp_iter]
+func astForComprehension(compFor *grammar.ComprehensionFor) ([]*Comprehension, error) {
+	comprehensions := make([]*Comprehension, 0)
+	for compFor != nil {
+		children := compFor.Children()
+		target, err := astForTarget(children[1].(*grammar.ExpressionList))
+		if err != nil {
+			return nil, err
+		}
+		iter, err := astForExpression(children[3])
+		if err != nil {
+			return nil, err
+		}
+		comprehension := NewComprehension(target, iter, make([]Expression, 0))
+		comprehensions = append(comprehensions, comprehension)
+
+		compFor = nil
+		if len(children) < 5 {
+			break
+		}
+		compIter := children[4].(*grammar.ComprehensionIterator)
+		for compIter != nil {
+			switch child := compIter.Child().(type) {
+			case *grammar.ComprehensionFor:
+				compFor = child
+				compIter = nil
+			case *grammar.ComprehensionIf:
+				parts := child.Children()
+				condition, err := astForExpression(parts[1])
 				if err != nil {
-					return nil
+					return nil, err
+				}
+				comprehension.Ifs = append(comprehension.Ifs, condition)
+				compIter = nil
+				if len(parts) == 3 {
+					compIter = parts[2].(*grammar.ComprehensionIterator)
 				}
-				return NewNum(value)
 			}
 		}
 	}
-	return nil
+	return comprehensions, nil
+}
+
+// yield_expr: 'yield' [yield_arg]
+// yield_arg: 'from' test | testlist
+func astForYieldExpression(root *grammar.YieldExpression) (Expression, error) {
+	children := root.Children()
+	if len(children) == 1 {
+		return expressionAt(NewYield(nil), root), nil
+	}
+
+	arg := children[1].(*grammar.YieldArgument).Children()
+	if len(arg) == 2 {
+		value, err := astForExpression(arg[1])
+		if err != nil {
+			return nil, err
+		}
+		return expressionAt(NewYieldFrom(value), root), nil
+	}
+	value, err := astForTestlist(arg[0].(grammarList))
+	if err != nil {
+		return nil, err
+	}
+	return expressionAt(NewYield(value), root), nil
 }
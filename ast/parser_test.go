@@ -0,0 +1,141 @@
+package ast
+
+import (
+	"testing"
+
+	"github.com/brettlangdon/gython/grammar"
+	"github.com/brettlangdon/gython/scanner"
+)
+
+// parseVersion parses source with the grammar of version and lowers it, failing the test on a grammar error
+func parseVersion(t *testing.T, source string, version scanner.Version) (Mod, error) {
+	tokenizer := scanner.NewBytesScanner([]byte(source))
+	tokenizer.SetVersion(version)
+	gp := grammar.NewGrammarParser(tokenizer)
+	start := gp.Parse()
+	if len(gp.Errors) > 0 {
+		t.Fatalf("parsing %q: %s", source, gp.Errors[0])
+	}
+	return ASTFromGrammar(start)
+}
+
+func TestBareStarTrailingComma(t *testing.T) {
+	tests := []struct {
+		source    string
+		colOffset int
+	}{
+		{"def f(*,): pass\n", 6},
+		{"lambda *,: 0\n", 7},
+		{"def f(*, **k): pass\n", 9},
+	}
+	for _, test := range tests {
+		mod, err := parseVersion(t, test.source, scanner.Python36)
+		syntaxErr, isSyntaxErr := err.(*SyntaxError)
+		if !isSyntaxErr || syntaxErr.Message != "named arguments must follow bare *" {
+			t.Errorf("ASTFromGrammar(%q) = %v, %v, want a bare * SyntaxError", test.source, mod, err)
+		} else if syntaxErr.Lineno != 1 || syntaxErr.ColOffset != test.colOffset {
+			t.Errorf("ASTFromGrammar(%q) placed the error at %d:%d, want 1:%d", test.source, syntaxErr.Lineno, syntaxErr.ColOffset, test.colOffset)
+		}
+	}
+}
+
+func TestASTFromGrammarStatements(t *testing.T) {
+	// The expected dumps are from Python's ast.dump
+	tests := []struct {
+		source string
+		dump   string
+	}{
+		{"@d\ndef f(a: int, *, b=1) -> str:\n    '''doc'''\n    return a\n", "Module(body=[FunctionDef(name='f', args=arguments(args=[arg(arg='a', annotation=Name(id='int', ctx=Load()))], vararg=None, kwonlyargs=[arg(arg='b', annotation=None)], kw_defaults=[Num(n=1)], kwarg=None, defaults=[]), body=[Expr(value=Str(s='doc')), Return(value=Name(id='a', ctx=Load()))], decorator_list=[Name(id='d', ctx=Load())], returns=Name(id='str', ctx=Load()))])"},
+		{"async def f():\n    await a\n    async for b in c:\n        pass\n    async with d as e, f:\n        pass\n", "Module(body=[AsyncFunctionDef(name='f', args=arguments(args=[], vararg=None, kwonlyargs=[], kw_defaults=[], kwarg=None, defaults=[]), body=[Expr(value=Await(value=Name(id='a', ctx=Load()))), AsyncFor(target=Name(id='b', ctx=Store()), iter=Name(id='c', ctx=Load()), body=[Pass()], orelse=[]), AsyncWith(items=[withitem(context_expr=Name(id='d', ctx=Load()), optional_vars=Name(id='e', ctx=Store())), withitem(context_expr=Name(id='f', ctx=Load()), optional_vars=None)], body=[Pass()])], decorator_list=[], returns=None)])"},
+		{"class C(B, metaclass=M):\n    x = 1\n", "Module(body=[ClassDef(name='C', bases=[Name(id='B', ctx=Load())], keywords=[keyword(arg='metaclass', value=Name(id='M', ctx=Load()))], body=[Assign(targets=[Name(id='x', ctx=Store())], value=Num(n=1))], decorator_list=[])])"},
+		{"del a, b[0], c.d\n", "Module(body=[Delete(targets=[Name(id='a', ctx=Del()), Subscript(value=Name(id='b', ctx=Load()), slice=Index(value=Num(n=0)), ctx=Del()), Attribute(value=Name(id='c', ctx=Load()), attr='d', ctx=Del())])])"},
+		{"a += 1\nb[0] **= 2\n", "Module(body=[AugAssign(target=Name(id='a', ctx=Store()), op=Add(), value=Num(n=1)), AugAssign(target=Subscript(value=Name(id='b', ctx=Load()), slice=Index(value=Num(n=0)), ctx=Store()), op=Pow(), value=Num(n=2))])"},
+		{"for a, b in c:\n    break\nelse:\n    continue\n", "Module(body=[For(target=Tuple(elts=[Name(id='a', ctx=Store()), Name(id='b', ctx=Store())], ctx=Store()), iter=Name(id='c', ctx=Load()), body=[Break()], orelse=[Continue()])])"},
+		{"while a:\n    pass\nelse:\n    pass\n", "Module(body=[While(test=Name(id='a', ctx=Load()), body=[Pass()], orelse=[Pass()])])"},
+		{"if a:\n    pass\nelif b:\n    pass\nelse:\n    pass\n", "Module(body=[If(test=Name(id='a', ctx=Load()), body=[Pass()], orelse=[If(test=Name(id='b', ctx=Load()), body=[Pass()], orelse=[Pass()])])])"},
+		{"with a as (b, c), d:\n    pass\n", "Module(body=[With(items=[withitem(context_expr=Name(id='a', ctx=Load()), optional_vars=Tuple(elts=[Name(id='b', ctx=Store()), Name(id='c', ctx=Store())], ctx=Store())), withitem(context_expr=Name(id='d', ctx=Load()), optional_vars=None)], body=[Pass()])])"},
+		{"raise a from b\nraise\n", "Module(body=[Raise(exc=Name(id='a', ctx=Load()), cause=Name(id='b', ctx=Load())), Raise(exc=None, cause=None)])"},
+		{"try:\n    pass\nexcept A as e:\n    pass\nexcept:\n    pass\nelse:\n    pass\nfinally:\n    pass\n", "Module(body=[Try(body=[Pass()], handlers=[ExceptHandler(type=Name(id='A', ctx=Load()), name='e', body=[Pass()]), ExceptHandler(type=None, name=None, body=[Pass()])], orelse=[Pass()], finalbody=[Pass()])])"},
+		{"assert a, 'b'\n", "Module(body=[Assert(test=Name(id='a', ctx=Load()), msg=Str(s='b'))])"},
+		{"import a.b as c, d\nfrom .. e import f as g, h\nfrom . import *\n", "Module(body=[Import(names=[alias(name='a.b', asname='c'), alias(name='d', asname=None)]), ImportFrom(module='e', names=[alias(name='f', asname='g'), alias(name='h', asname=None)], level=2), ImportFrom(module=None, names=[alias(name='*', asname=None)], level=1)])"},
+		{"def f():\n    global a, b\n    nonlocal c\n", "Module(body=[FunctionDef(name='f', args=arguments(args=[], vararg=None, kwonlyargs=[], kw_defaults=[], kwarg=None, defaults=[]), body=[Global(names=['a', 'b']), Nonlocal(names=['c'])], decorator_list=[], returns=None)])"},
+		{"a = b = c\na; b\n", "Module(body=[Assign(targets=[Name(id='a', ctx=Store()), Name(id='b', ctx=Store())], value=Name(id='c', ctx=Load())), Expr(value=Name(id='a', ctx=Load())), Expr(value=Name(id='b', ctx=Load()))])"},
+		{"x = (yield)\n", "Module(body=[Assign(targets=[Name(id='x', ctx=Store())], value=Yield(value=None))])"},
+	}
+	for _, test := range tests {
+		if dump := Dump(parseSource(t, test.source), false); dump != test.dump {
+			t.Errorf("Dump(%q) =\n%s\nwant\n%s", test.source, dump, test.dump)
+		}
+	}
+}
+
+func TestASTFromGrammarPositions(t *testing.T) {
+	// The expected dumps are from Python's ast.dump with include_attributes=True
+	tests := []struct {
+		source string
+		dump   string
+	}{
+		{"x = a.b[c](d, *e, k=f)\n", "Module(body=[Assign(targets=[Name(id='x', ctx=Store(), lineno=1, col_offset=0)], value=Call(func=Subscript(value=Attribute(value=Name(id='a', ctx=Load(), lineno=1, col_offset=4), attr='b', ctx=Load(), lineno=1, col_offset=4), slice=Index(value=Name(id='c', ctx=Load(), lineno=1, col_offset=8)), ctx=Load(), lineno=1, col_offset=4), args=[Name(id='d', ctx=Load(), lineno=1, col_offset=11), Starred(value=Name(id='e', ctx=Load(), lineno=1, col_offset=15), ctx=Load(), lineno=1, col_offset=14)], keywords=[keyword(arg='k', value=Name(id='f', ctx=Load(), lineno=1, col_offset=20))], lineno=1, col_offset=4), lineno=1, col_offset=0)])"},
+		{"if a:\n    b = (1 +\n         2)\nelse:\n    c = -d\n", "Module(body=[If(test=Name(id='a', ctx=Load(), lineno=1, col_offset=3), body=[Assign(targets=[Name(id='b', ctx=Store(), lineno=2, col_offset=4)], value=BinOp(left=Num(n=1, lineno=2, col_offset=9), op=Add(), right=Num(n=2, lineno=3, col_offset=9), lineno=2, col_offset=9), lineno=2, col_offset=4)], orelse=[Assign(targets=[Name(id='c', ctx=Store(), lineno=5, col_offset=4)], value=UnaryOp(op=USub(), operand=Name(id='d', ctx=Load(), lineno=5, col_offset=9), lineno=5, col_offset=8), lineno=5, col_offset=4)], lineno=1, col_offset=0)])"},
+		{"@dec\nclass C:\n    def f(self, a):\n        return [x for x in a if x]\n", "Module(body=[ClassDef(name='C', bases=[], keywords=[], body=[FunctionDef(name='f', args=arguments(args=[arg(arg='self', annotation=None, lineno=3, col_offset=10), arg(arg='a', annotation=None, lineno=3, col_offset=16)], vararg=None, kwonlyargs=[], kw_defaults=[], kwarg=None, defaults=[]), body=[Return(value=ListComp(elt=Name(id='x', ctx=Load(), lineno=4, col_offset=16), generators=[comprehension(target=Name(id='x', ctx=Store(), lineno=4, col_offset=22), iter=Name(id='a', ctx=Load(), lineno=4, col_offset=27), ifs=[Name(id='x', ctx=Load(), lineno=4, col_offset=32)])], lineno=4, col_offset=16), lineno=4, col_offset=8)], decorator_list=[], returns=None, lineno=3, col_offset=4)], decorator_list=[Name(id='dec', ctx=Load(), lineno=1, col_offset=1)], lineno=1, col_offset=0)])"},
+		{"x = {'a': 1, **b}\ny = not a or b < c\n", "Module(body=[Assign(targets=[Name(id='x', ctx=Store(), lineno=1, col_offset=0)], value=Dict(keys=[Str(s='a', lineno=1, col_offset=5), None], values=[Num(n=1, lineno=1, col_offset=10), Name(id='b', ctx=Load(), lineno=1, col_offset=15)], lineno=1, col_offset=4), lineno=1, col_offset=0), Assign(targets=[Name(id='y', ctx=Store(), lineno=2, col_offset=0)], value=BoolOp(op=Or(), values=[UnaryOp(op=Not(), operand=Name(id='a', ctx=Load(), lineno=2, col_offset=8), lineno=2, col_offset=4), Compare(left=Name(id='b', ctx=Load(), lineno=2, col_offset=13), ops=[Lt()], comparators=[Name(id='c', ctx=Load(), lineno=2, col_offset=17)], lineno=2, col_offset=13)], lineno=2, col_offset=4), lineno=2, col_offset=0)])"},
+		// A multi-line string is at its start, as in Python 3.8, rather than the -1 col_offset of 3.5
+		{"x = '''a\nb'''\n", "Module(body=[Assign(targets=[Name(id='x', ctx=Store(), lineno=1, col_offset=0)], value=Str(s='a\\nb', lineno=1, col_offset=4), lineno=1, col_offset=0)])"},
+		{"x = 'é' + ''\n", "Module(body=[Assign(targets=[Name(id='x', ctx=Store(), lineno=1, col_offset=0)], value=BinOp(left=Str(s='é', lineno=1, col_offset=4), op=Add(), right=Str(s='', lineno=1, col_offset=11), lineno=1, col_offset=4), lineno=1, col_offset=0)])"},
+		{"with a as b:\n    lambda c: c\n", "Module(body=[With(items=[withitem(context_expr=Name(id='a', ctx=Load(), lineno=1, col_offset=5), optional_vars=Name(id='b', ctx=Store(), lineno=1, col_offset=10))], body=[Expr(value=Lambda(args=arguments(args=[arg(arg='c', annotation=None, lineno=2, col_offset=11)], vararg=None, kwonlyargs=[], kw_defaults=[], kwarg=None, defaults=[]), body=Name(id='c', ctx=Load(), lineno=2, col_offset=14), lineno=2, col_offset=4), lineno=2, col_offset=4)], lineno=1, col_offset=0)])"},
+	}
+	for _, test := range tests {
+		if dump := Dump(parseSource(t, test.source), true); dump != test.dump {
+			t.Errorf("Dump(%q, true) =\n%s\nwant\n%s", test.source, dump, test.dump)
+		}
+	}
+}
+
+func TestASTFromGrammarErrors(t *testing.T) {
+	// The expected messages and positions are from Python's ast.parse
+	tests := []struct {
+		source    string
+		message   string
+		lineno    int
+		colOffset int
+	}{
+		{"1 = x\n", "can't assign to literal", 1, 0},
+		{"None = 1\n", "can't assign to keyword", 1, 0},
+		{"f() = 1\n", "can't assign to function call", 1, 0},
+		{"a + b = 1\n", "can't assign to operator", 1, 0},
+		{"del f()\n", "can't delete function call", 1, 4},
+		{"x, 1 = y\n", "can't assign to literal", 1, 0},
+		{"f() += 1\n", "can't assign to function call", 1, 0},
+		{"(a, b) += 1\n", "illegal expression for augmented assignment", 1, 0},
+		{"[x for x in y] = 1\n", "can't assign to list comprehension", 1, 0},
+		{"lambda: 1 = 1\n", "can't assign to lambda", 1, 0},
+		{"a if b else c = 1\n", "can't assign to conditional expression", 1, 0},
+		{"'s' = 1\n", "can't assign to literal", 1, 0},
+		{"... = 1\n", "can't assign to Ellipsis", 1, 0},
+		{"True = 1\n", "can't assign to keyword", 1, 0},
+		{"(yield) = 1\n", "can't assign to yield expression", 1, 0},
+		{"for 1 in x: pass\n", "can't assign to literal", 1, 4},
+		{"with a as 1: pass\n", "can't assign to literal", 1, 5},
+		{"__debug__ = 1\n", "assignment to keyword", 1, 0},
+		{"f(None=1)\n", "keyword can't be an expression", 1, 2},
+		{"{a: b} = 1\n", "can't assign to literal", 1, 0},
+		{"not a = 1\n", "can't assign to operator", 1, 0},
+		{"a < b = 1\n", "can't assign to comparison", 1, 0},
+		{"del (a, 1)\n", "can't delete literal", 1, 4},
+		{"x.__debug__ = 1\n", "assignment to keyword", 1, 0},
+		{"-a = 1\n", "can't assign to operator", 1, 0},
+		{"f(**a, *b)\n", "iterable argument unpacking follows keyword argument unpacking", 1, 7},
+		{"f(a=1, b)\n", "positional argument follows keyword argument", 1, 7},
+		{"f(a for a in b, c)\n", "Generator expression must be parenthesized if not sole argument", 1, 2},
+		{"f(a=1, a=2)\n", "keyword argument repeated", 1, 7},
+	}
+	for _, test := range tests {
+		mod, err := parseVersion(t, test.source, scanner.Python36)
+		syntaxErr, isSyntaxErr := err.(*SyntaxError)
+		if !isSyntaxErr || syntaxErr.Message != test.message {
+			t.Errorf("ASTFromGrammar(%q) = %v, %v, want the SyntaxError %q", test.source, mod, err, test.message)
+		} else if syntaxErr.Lineno != test.lineno || syntaxErr.ColOffset != test.colOffset {
+			t.Errorf("ASTFromGrammar(%q) placed the error at %d:%d, want %d:%d", test.source, syntaxErr.Lineno, syntaxErr.ColOffset, test.lineno, test.colOffset)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package ast
+
+import "fmt"
+
+// SliceNode is what a Subscript subscripts with
+type SliceNode interface {
+	Node
+	slice()
+}
+
+// Slice is lower:upper:step, any of which may be nil
+type Slice struct {
+	Lower Expression
+	Upper Expression
+	Step  Expression
+}
+
+func NewSlice(lower Expression, upper Expression, step Expression) *Slice {
+	return &Slice{
+		Lower: lower,
+		Upper: upper,
+		Step:  step,
+	}
+}
+
+func (slice *Slice) node()          {}
+func (slice *Slice) slice()         {}
+func (slice *Slice) String() string { return Dump(slice, false) }
+func (slice *Slice) dump(dumper *dumper) string {
+	return fmt.Sprintf("Slice(lower=%s, upper=%s, step=%s)", dumper.node(slice.Lower), dumper.node(slice.Upper), dumper.node(slice.Step))
+}
+func (slice *Slice) Fields() []string     { return []string{"lower", "upper", "step"} }
+func (slice *Slice) Attributes() []string { return nil }
+func (slice *Slice) fieldPointers() []interface{} {
+	return []interface{}{&slice.Lower, &slice.Upper, &slice.Step}
+}
+
+// ExtSlice is a subscript of several dimensions, at least one of them a Slice
+type ExtSlice struct {
+	Dimensions []SliceNode
+}
+
+func NewExtSlice(dimensions []SliceNode) *ExtSlice {
+	return &ExtSlice{
+		Dimensions: dimensions,
+	}
+}
+
+func (extSlice *ExtSlice) node()          {}
+func (extSlice *ExtSlice) slice()         {}
+func (extSlice *ExtSlice) String() string { return Dump(extSlice, false) }
+func (extSlice *ExtSlice) dump(dumper *dumper) string {
+	dims := make([]string, 0, len(extSlice.Dimensions))
+	for _, dim := range extSlice.Dimensions {
+		dims = append(dims, dumper.node(dim))
+	}
+	return fmt.Sprintf("ExtSlice(dims=%s)", dumpList(dims))
+}
+func (extSlice *ExtSlice) Fields() []string     { return []string{"dims"} }
+func (extSlice *ExtSlice) Attributes() []string { return nil }
+func (extSlice *ExtSlice) fieldPointers() []interface{} {
+	return []interface{}{&extSlice.Dimensions}
+}
+
+type Index struct {
+	Value Expression
+}
+
+func NewIndex(value Expression) *Index {
+	return &Index{
+		Value: value,
+	}
+}
+
+func (index *Index) node()          {}
+func (index *Index) slice()         {}
+func (index *Index) String() string { return Dump(index, false) }
+func (index *Index) dump(dumper *dumper) string {
+	return fmt.Sprintf("Index(value=%s)", dumper.node(index.Value))
+}
+func (index *Index) Fields() []string     { return []string{"value"} }
+func (index *Index) Attributes() []string { return nil }
+func (index *Index) fieldPointers() []interface{} {
+	return []interface{}{&index.Value}
+}
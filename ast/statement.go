@@ -2,15 +2,186 @@ package ast
 
 import (
 	"fmt"
-	"strings"
+
+	"github.com/brettlangdon/gython/gython"
 )
 
 type Statement interface {
 	Node
 	stmt()
+	Pos() *Position
+}
+
+type FunctionDef struct {
+	Position
+
+	Name          *gython.Unicode
+	Args          *Arguments
+	Body          []Statement
+	DecoratorList []Expression
+	Returns       Expression
+}
+
+func NewFunctionDef(name string, args *Arguments, body []Statement, decorators []Expression, returns Expression) *FunctionDef {
+	return &FunctionDef{
+		Name:          gython.NewUnicode([]byte(name)),
+		Args:          args,
+		Body:          body,
+		DecoratorList: decorators,
+		Returns:       returns,
+	}
+}
+
+func (functionDef *FunctionDef) node()          {}
+func (functionDef *FunctionDef) stmt()          {}
+func (functionDef *FunctionDef) String() string { return Dump(functionDef, false) }
+func (functionDef *FunctionDef) dump(dumper *dumper) string {
+	return fmt.Sprintf(
+		"FunctionDef(name=%s, args=%s, body=%s, decorator_list=%s, returns=%s)",
+		dumpIdentifier(functionDef.Name),
+		dumper.arguments(functionDef.Args),
+		dumper.statements(functionDef.Body),
+		dumper.expressions(functionDef.DecoratorList),
+		dumper.node(functionDef.Returns),
+	)
+}
+func (functionDef *FunctionDef) Fields() []string {
+	return []string{"name", "args", "body", "decorator_list", "returns"}
+}
+func (functionDef *FunctionDef) fieldPointers() []interface{} {
+	return []interface{}{&functionDef.Name, &functionDef.Args, &functionDef.Body, &functionDef.DecoratorList, &functionDef.Returns}
+}
+
+type AsyncFunctionDef struct {
+	Position
+
+	Name          *gython.Unicode
+	Args          *Arguments
+	Body          []Statement
+	DecoratorList []Expression
+	Returns       Expression
+}
+
+func NewAsyncFunctionDef(name string, args *Arguments, body []Statement, decorators []Expression, returns Expression) *AsyncFunctionDef {
+	return &AsyncFunctionDef{
+		Name:          gython.NewUnicode([]byte(name)),
+		Args:          args,
+		Body:          body,
+		DecoratorList: decorators,
+		Returns:       returns,
+	}
+}
+
+func (asyncFunctionDef *AsyncFunctionDef) node()          {}
+func (asyncFunctionDef *AsyncFunctionDef) stmt()          {}
+func (asyncFunctionDef *AsyncFunctionDef) String() string { return Dump(asyncFunctionDef, false) }
+func (asyncFunctionDef *AsyncFunctionDef) dump(dumper *dumper) string {
+	return fmt.Sprintf(
+		"AsyncFunctionDef(name=%s, args=%s, body=%s, decorator_list=%s, returns=%s)",
+		dumpIdentifier(asyncFunctionDef.Name),
+		dumper.arguments(asyncFunctionDef.Args),
+		dumper.statements(asyncFunctionDef.Body),
+		dumper.expressions(asyncFunctionDef.DecoratorList),
+		dumper.node(asyncFunctionDef.Returns),
+	)
+}
+func (asyncFunctionDef *AsyncFunctionDef) Fields() []string {
+	return []string{"name", "args", "body", "decorator_list", "returns"}
+}
+func (asyncFunctionDef *AsyncFunctionDef) fieldPointers() []interface{} {
+	return []interface{}{&asyncFunctionDef.Name, &asyncFunctionDef.Args, &asyncFunctionDef.Body, &asyncFunctionDef.DecoratorList, &asyncFunctionDef.Returns}
+}
+
+type ClassDef struct {
+	Position
+
+	Name          *gython.Unicode
+	Bases         []Expression
+	Keywords      []*Keyword
+	Body          []Statement
+	DecoratorList []Expression
+}
+
+func NewClassDef(name string, bases []Expression, keywords []*Keyword, body []Statement, decorators []Expression) *ClassDef {
+	return &ClassDef{
+		Name:          gython.NewUnicode([]byte(name)),
+		Bases:         bases,
+		Keywords:      keywords,
+		Body:          body,
+		DecoratorList: decorators,
+	}
+}
+
+func (classDef *ClassDef) node()          {}
+func (classDef *ClassDef) stmt()          {}
+func (classDef *ClassDef) String() string { return Dump(classDef, false) }
+func (classDef *ClassDef) dump(dumper *dumper) string {
+	return fmt.Sprintf(
+		"ClassDef(name=%s, bases=%s, keywords=%s, body=%s, decorator_list=%s)",
+		dumpIdentifier(classDef.Name),
+		dumper.expressions(classDef.Bases),
+		dumper.keywords(classDef.Keywords),
+		dumper.statements(classDef.Body),
+		dumper.expressions(classDef.DecoratorList),
+	)
+}
+func (classDef *ClassDef) Fields() []string {
+	return []string{"name", "bases", "keywords", "body", "decorator_list"}
+}
+func (classDef *ClassDef) fieldPointers() []interface{} {
+	return []interface{}{&classDef.Name, &classDef.Bases, &classDef.Keywords, &classDef.Body, &classDef.DecoratorList}
+}
+
+// Return is a return statement, Value is nil for a bare return
+type Return struct {
+	Position
+
+	Value Expression
+}
+
+func NewReturn(value Expression) *Return {
+	return &Return{
+		Value: value,
+	}
+}
+
+func (ret *Return) node()          {}
+func (ret *Return) stmt()          {}
+func (ret *Return) String() string { return Dump(ret, false) }
+func (ret *Return) dump(dumper *dumper) string {
+	return fmt.Sprintf("Return(value=%s)", dumper.node(ret.Value))
+}
+func (ret *Return) Fields() []string { return []string{"value"} }
+func (ret *Return) fieldPointers() []interface{} {
+	return []interface{}{&ret.Value}
+}
+
+type Delete struct {
+	Position
+
+	Targets []Expression
+}
+
+func NewDelete(targets []Expression) *Delete {
+	return &Delete{
+		Targets: targets,
+	}
+}
+
+func (del *Delete) node()          {}
+func (del *Delete) stmt()          {}
+func (del *Delete) String() string { return Dump(del, false) }
+func (del *Delete) dump(dumper *dumper) string {
+	return fmt.Sprintf("Delete(targets=%s)", dumper.expressions(del.Targets))
+}
+func (del *Delete) Fields() []string { return []string{"targets"} }
+func (del *Delete) fieldPointers() []interface{} {
+	return []interface{}{&del.Targets}
 }
 
 type Assign struct {
+	Position
+
 	Targets []Expression
 	Value   Expression
 }
@@ -27,11 +198,457 @@ func (assign *Assign) stmt() {}
 func (assign *Assign) Append(target Expression) {
 	assign.Targets = append(assign.Targets, target)
 }
-func (assign *Assign) String() string {
-	exprs := make([]string, 0)
-	for _, expr := range assign.Targets {
-		exprs = append(exprs, expr.String())
+func (assign *Assign) String() string { return Dump(assign, false) }
+func (assign *Assign) dump(dumper *dumper) string {
+	return fmt.Sprintf("Assign(targets=%s, value=%s)", dumper.expressions(assign.Targets), dumper.node(assign.Value))
+}
+func (assign *Assign) Fields() []string { return []string{"targets", "value"} }
+func (assign *Assign) fieldPointers() []interface{} {
+	return []interface{}{&assign.Targets, &assign.Value}
+}
+
+type AugAssign struct {
+	Position
+
+	Target   Expression
+	Operator Operator
+	Value    Expression
+}
+
+func NewAugAssign(target Expression, op Operator, value Expression) *AugAssign {
+	return &AugAssign{
+		Target:   target,
+		Operator: op,
+		Value:    value,
+	}
+}
+
+func (augAssign *AugAssign) node()          {}
+func (augAssign *AugAssign) stmt()          {}
+func (augAssign *AugAssign) String() string { return Dump(augAssign, false) }
+func (augAssign *AugAssign) dump(dumper *dumper) string {
+	return fmt.Sprintf("AugAssign(target=%s, op=%s, value=%s)", dumper.node(augAssign.Target), dumper.node(augAssign.Operator), dumper.node(augAssign.Value))
+}
+func (augAssign *AugAssign) Fields() []string { return []string{"target", "op", "value"} }
+func (augAssign *AugAssign) fieldPointers() []interface{} {
+	return []interface{}{&augAssign.Target, &augAssign.Operator, &augAssign.Value}
+}
+
+type For struct {
+	Position
+
+	Target Expression
+	Iter   Expression
+	Body   []Statement
+	OrElse []Statement
+}
+
+func NewFor(target Expression, iter Expression, body []Statement, orElse []Statement) *For {
+	return &For{
+		Target: target,
+		Iter:   iter,
+		Body:   body,
+		OrElse: orElse,
+	}
+}
+
+func (f *For) node()          {}
+func (f *For) stmt()          {}
+func (f *For) String() string { return Dump(f, false) }
+func (f *For) dump(dumper *dumper) string {
+	return fmt.Sprintf(
+		"For(target=%s, iter=%s, body=%s, orelse=%s)",
+		dumper.node(f.Target), dumper.node(f.Iter), dumper.statements(f.Body), dumper.statements(f.OrElse),
+	)
+}
+func (f *For) Fields() []string { return []string{"target", "iter", "body", "orelse"} }
+func (f *For) fieldPointers() []interface{} {
+	return []interface{}{&f.Target, &f.Iter, &f.Body, &f.OrElse}
+}
+
+type AsyncFor struct {
+	Position
+
+	Target Expression
+	Iter   Expression
+	Body   []Statement
+	OrElse []Statement
+}
+
+func NewAsyncFor(target Expression, iter Expression, body []Statement, orElse []Statement) *AsyncFor {
+	return &AsyncFor{
+		Target: target,
+		Iter:   iter,
+		Body:   body,
+		OrElse: orElse,
+	}
+}
+
+func (asyncFor *AsyncFor) node()          {}
+func (asyncFor *AsyncFor) stmt()          {}
+func (asyncFor *AsyncFor) String() string { return Dump(asyncFor, false) }
+func (asyncFor *AsyncFor) dump(dumper *dumper) string {
+	return fmt.Sprintf(
+		"AsyncFor(target=%s, iter=%s, body=%s, orelse=%s)",
+		dumper.node(asyncFor.Target), dumper.node(asyncFor.Iter), dumper.statements(asyncFor.Body), dumper.statements(asyncFor.OrElse),
+	)
+}
+func (asyncFor *AsyncFor) Fields() []string { return []string{"target", "iter", "body", "orelse"} }
+func (asyncFor *AsyncFor) fieldPointers() []interface{} {
+	return []interface{}{&asyncFor.Target, &asyncFor.Iter, &asyncFor.Body, &asyncFor.OrElse}
+}
+
+type While struct {
+	Position
+
+	Test   Expression
+	Body   []Statement
+	OrElse []Statement
+}
+
+func NewWhile(test Expression, body []Statement, orElse []Statement) *While {
+	return &While{
+		Test:   test,
+		Body:   body,
+		OrElse: orElse,
+	}
+}
+
+func (while *While) node()          {}
+func (while *While) stmt()          {}
+func (while *While) String() string { return Dump(while, false) }
+func (while *While) dump(dumper *dumper) string {
+	return fmt.Sprintf("While(test=%s, body=%s, orelse=%s)", dumper.node(while.Test), dumper.statements(while.Body), dumper.statements(while.OrElse))
+}
+func (while *While) Fields() []string { return []string{"test", "body", "orelse"} }
+func (while *While) fieldPointers() []interface{} {
+	return []interface{}{&while.Test, &while.Body, &while.OrElse}
+}
+
+// If is an if statement, an elif is an If which is the only statement in OrElse
+type If struct {
+	Position
+
+	Test   Expression
+	Body   []Statement
+	OrElse []Statement
+}
+
+func NewIf(test Expression, body []Statement, orElse []Statement) *If {
+	return &If{
+		Test:   test,
+		Body:   body,
+		OrElse: orElse,
+	}
+}
+
+func (i *If) node()          {}
+func (i *If) stmt()          {}
+func (i *If) String() string { return Dump(i, false) }
+func (i *If) dump(dumper *dumper) string {
+	return fmt.Sprintf("If(test=%s, body=%s, orelse=%s)", dumper.node(i.Test), dumper.statements(i.Body), dumper.statements(i.OrElse))
+}
+func (i *If) Fields() []string { return []string{"test", "body", "orelse"} }
+func (i *If) fieldPointers() []interface{} {
+	return []interface{}{&i.Test, &i.Body, &i.OrElse}
+}
+
+type With struct {
+	Position
+
+	Items []*WithItem
+	Body  []Statement
+}
+
+func NewWith(items []*WithItem, body []Statement) *With {
+	return &With{
+		Items: items,
+		Body:  body,
+	}
+}
+
+func (with *With) node()          {}
+func (with *With) stmt()          {}
+func (with *With) String() string { return Dump(with, false) }
+func (with *With) dump(dumper *dumper) string {
+	return fmt.Sprintf("With(items=%s, body=%s)", dumper.withItems(with.Items), dumper.statements(with.Body))
+}
+func (with *With) Fields() []string { return []string{"items", "body"} }
+func (with *With) fieldPointers() []interface{} {
+	return []interface{}{&with.Items, &with.Body}
+}
+
+type AsyncWith struct {
+	Position
+
+	Items []*WithItem
+	Body  []Statement
+}
+
+func NewAsyncWith(items []*WithItem, body []Statement) *AsyncWith {
+	return &AsyncWith{
+		Items: items,
+		Body:  body,
+	}
+}
+
+func (asyncWith *AsyncWith) node()          {}
+func (asyncWith *AsyncWith) stmt()          {}
+func (asyncWith *AsyncWith) String() string { return Dump(asyncWith, false) }
+func (asyncWith *AsyncWith) dump(dumper *dumper) string {
+	return fmt.Sprintf("AsyncWith(items=%s, body=%s)", dumper.withItems(asyncWith.Items), dumper.statements(asyncWith.Body))
+}
+func (asyncWith *AsyncWith) Fields() []string { return []string{"items", "body"} }
+func (asyncWith *AsyncWith) fieldPointers() []interface{} {
+	return []interface{}{&asyncWith.Items, &asyncWith.Body}
+}
+
+// Raise is a raise statement, Exc is nil for a bare raise and Cause is the expression after "from"
+type Raise struct {
+	Position
+
+	Exc   Expression
+	Cause Expression
+}
+
+func NewRaise(exc Expression, cause Expression) *Raise {
+	return &Raise{
+		Exc:   exc,
+		Cause: cause,
+	}
+}
+
+func (raise *Raise) node()          {}
+func (raise *Raise) stmt()          {}
+func (raise *Raise) String() string { return Dump(raise, false) }
+func (raise *Raise) dump(dumper *dumper) string {
+	return fmt.Sprintf("Raise(exc=%s, cause=%s)", dumper.node(raise.Exc), dumper.node(raise.Cause))
+}
+func (raise *Raise) Fields() []string { return []string{"exc", "cause"} }
+func (raise *Raise) fieldPointers() []interface{} {
+	return []interface{}{&raise.Exc, &raise.Cause}
+}
+
+type Try struct {
+	Position
+
+	Body      []Statement
+	Handlers  []*ExceptHandler
+	OrElse    []Statement
+	FinalBody []Statement
+}
+
+func NewTry(body []Statement, handlers []*ExceptHandler, orElse []Statement, finalBody []Statement) *Try {
+	return &Try{
+		Body:      body,
+		Handlers:  handlers,
+		OrElse:    orElse,
+		FinalBody: finalBody,
+	}
+}
+
+func (try *Try) node()          {}
+func (try *Try) stmt()          {}
+func (try *Try) String() string { return Dump(try, false) }
+func (try *Try) dump(dumper *dumper) string {
+	handlers := make([]string, 0, len(try.Handlers))
+	for _, handler := range try.Handlers {
+		handlers = append(handlers, dumper.node(handler))
+	}
+	return fmt.Sprintf(
+		"Try(body=%s, handlers=%s, orelse=%s, finalbody=%s)",
+		dumper.statements(try.Body), dumpList(handlers), dumper.statements(try.OrElse), dumper.statements(try.FinalBody),
+	)
+}
+func (try *Try) Fields() []string { return []string{"body", "handlers", "orelse", "finalbody"} }
+func (try *Try) fieldPointers() []interface{} {
+	return []interface{}{&try.Body, &try.Handlers, &try.OrElse, &try.FinalBody}
+}
+
+type Assert struct {
+	Position
+
+	Test    Expression
+	Message Expression
+}
+
+func NewAssert(test Expression, msg Expression) *Assert {
+	return &Assert{
+		Test:    test,
+		Message: msg,
+	}
+}
+
+func (assert *Assert) node()          {}
+func (assert *Assert) stmt()          {}
+func (assert *Assert) String() string { return Dump(assert, false) }
+func (assert *Assert) dump(dumper *dumper) string {
+	return fmt.Sprintf("Assert(test=%s, msg=%s)", dumper.node(assert.Test), dumper.node(assert.Message))
+}
+func (assert *Assert) Fields() []string { return []string{"test", "msg"} }
+func (assert *Assert) fieldPointers() []interface{} {
+	return []interface{}{&assert.Test, &assert.Message}
+}
+
+type Import struct {
+	Position
+
+	Names []*Alias
+}
+
+func NewImport(names []*Alias) *Import {
+	return &Import{
+		Names: names,
+	}
+}
+
+func (imp *Import) node()          {}
+func (imp *Import) stmt()          {}
+func (imp *Import) String() string { return Dump(imp, false) }
+func (imp *Import) dump(dumper *dumper) string {
+	return fmt.Sprintf("Import(names=%s)", dumper.aliases(imp.Names))
+}
+func (imp *Import) Fields() []string { return []string{"names"} }
+func (imp *Import) fieldPointers() []interface{} {
+	return []interface{}{&imp.Names}
+}
+
+// ImportFrom is a from import, Module is nil for "from . import x" and Level counts the leading dots
+type ImportFrom struct {
+	Position
+
+	Module *gython.Unicode
+	Names  []*Alias
+	Level  int
+}
+
+func NewImportFrom(module *gython.Unicode, names []*Alias, level int) *ImportFrom {
+	return &ImportFrom{
+		Module: module,
+		Names:  names,
+		Level:  level,
+	}
+}
+
+func (importFrom *ImportFrom) node()          {}
+func (importFrom *ImportFrom) stmt()          {}
+func (importFrom *ImportFrom) String() string { return Dump(importFrom, false) }
+func (importFrom *ImportFrom) dump(dumper *dumper) string {
+	return fmt.Sprintf("ImportFrom(module=%s, names=%s, level=%d)", dumpIdentifier(importFrom.Module), dumper.aliases(importFrom.Names), importFrom.Level)
+}
+func (importFrom *ImportFrom) Fields() []string { return []string{"module", "names", "level"} }
+func (importFrom *ImportFrom) fieldPointers() []interface{} {
+	return []interface{}{&importFrom.Module, &importFrom.Names, &importFrom.Level}
+}
+
+type Global struct {
+	Position
+
+	Names []*gython.Unicode
+}
+
+func NewGlobal(names []*gython.Unicode) *Global {
+	return &Global{
+		Names: names,
 	}
+}
 
-	return fmt.Sprintf("Assign(targets=[%s], value=%s)", strings.Join(exprs, ", "), assign.Value.String())
+func (global *Global) node()          {}
+func (global *Global) stmt()          {}
+func (global *Global) String() string { return Dump(global, false) }
+func (global *Global) dump(dumper *dumper) string {
+	return fmt.Sprintf("Global(names=%s)", dumpIdentifiers(global.Names))
+}
+func (global *Global) Fields() []string { return []string{"names"} }
+func (global *Global) fieldPointers() []interface{} {
+	return []interface{}{&global.Names}
 }
+
+type Nonlocal struct {
+	Position
+
+	Names []*gython.Unicode
+}
+
+func NewNonlocal(names []*gython.Unicode) *Nonlocal {
+	return &Nonlocal{
+		Names: names,
+	}
+}
+
+func (nonlocal *Nonlocal) node()          {}
+func (nonlocal *Nonlocal) stmt()          {}
+func (nonlocal *Nonlocal) String() string { return Dump(nonlocal, false) }
+func (nonlocal *Nonlocal) dump(dumper *dumper) string {
+	return fmt.Sprintf("Nonlocal(names=%s)", dumpIdentifiers(nonlocal.Names))
+}
+func (nonlocal *Nonlocal) Fields() []string { return []string{"names"} }
+func (nonlocal *Nonlocal) fieldPointers() []interface{} {
+	return []interface{}{&nonlocal.Names}
+}
+
+// Expr is an expression used as a statement
+type Expr struct {
+	Position
+
+	Value Expression
+}
+
+func NewExpr(value Expression) *Expr {
+	return &Expr{
+		Value: value,
+	}
+}
+
+func (expr *Expr) node()          {}
+func (expr *Expr) stmt()          {}
+func (expr *Expr) String() string { return Dump(expr, false) }
+func (expr *Expr) dump(dumper *dumper) string {
+	return fmt.Sprintf("Expr(value=%s)", dumper.node(expr.Value))
+}
+func (expr *Expr) Fields() []string { return []string{"value"} }
+func (expr *Expr) fieldPointers() []interface{} {
+	return []interface{}{&expr.Value}
+}
+
+type Pass struct {
+	Position
+}
+
+func NewPass() *Pass {
+	return &Pass{}
+}
+
+func (pass *Pass) node()          {}
+func (pass *Pass) stmt()          {}
+func (pass *Pass) String() string { return "Pass()" }
+
+func (pass *Pass) Fields() []string { return nil }
+
+type Break struct {
+	Position
+}
+
+func NewBreak() *Break {
+	return &Break{}
+}
+
+func (brk *Break) node()          {}
+func (brk *Break) stmt()          {}
+func (brk *Break) String() string { return "Break()" }
+
+func (brk *Break) Fields() []string { return nil }
+
+type Continue struct {
+	Position
+}
+
+func NewContinue() *Continue {
+	return &Continue{}
+}
+
+func (cont *Continue) node()          {}
+func (cont *Continue) stmt()          {}
+func (cont *Continue) String() string { return "Continue()" }
+
+func (cont *Continue) Fields() []string { return nil }
@@ -0,0 +1,263 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// UnicodeDecodeError reports an escape sequence in a str literal which cannot be decoded, like Python's UnicodeDecodeError
+type UnicodeDecodeError struct {
+	Encoding string
+	Object   []byte
+	Start    int
+	End      int
+	Reason   string
+}
+
+func (err *UnicodeDecodeError) Error() string {
+	if err.End == err.Start+1 {
+		return fmt.Sprintf("'%s' codec can't decode byte 0x%02x in position %d: %s", err.Encoding, err.Object[err.Start], err.Start, err.Reason)
+	}
+	return fmt.Sprintf("'%s' codec can't decode bytes in position %d-%d: %s", err.Encoding, err.Start, err.End-1, err.Reason)
+}
+
+// ParseString decodes the literal of a STRING token, following CPython's parsestr. The value of
+// a str literal is UTF-8, with lone surrogates from \u escapes kept in their three byte form.
+// An f-string has no constant value and is an error.
+func ParseString(literal string) (value []byte, isBytes bool, err error) {
+	quote := strings.IndexAny(literal, "'\"")
+	prefix := strings.ToLower(literal[:quote])
+	isBytes = strings.Contains(prefix, "b")
+	if strings.Contains(prefix, "f") {
+		return nil, false, &ValueError{Literal: literal, Message: "f-strings cannot be decoded to a constant"}
+	}
+
+	body := literal[quote:]
+	width := 1
+	if len(body) >= 6 && (strings.HasPrefix(body, `"""`) || strings.HasPrefix(body, "'''")) {
+		width = 3
+	}
+	body = body[width : len(body)-width]
+
+	if isBytes {
+		for i := 0; i < len(body); i++ {
+			if body[i] >= utf8.RuneSelf {
+				return nil, isBytes, &SyntaxError{Message: "bytes can only contain ASCII literal characters."}
+			}
+		}
+	}
+
+	switch {
+	case strings.Contains(prefix, "r") || !strings.Contains(body, `\`):
+		return []byte(body), isBytes, nil
+	case isBytes:
+		value, err = decodeBytesEscape(body)
+	default:
+		value, err = decodeUnicodeEscape(escapeNonASCII(body))
+	}
+	return value, isBytes, err
+}
+
+// isFormattedString reports whether the literal of a STRING token has an f prefix
+func isFormattedString(literal string) bool {
+	prefix := literal[:strings.IndexAny(literal, "'\"")]
+	return strings.ContainsAny(prefix, "fF")
+}
+
+// escapeNonASCII rewrites the non-ASCII characters of a str literal as \U escapes, like
+// decode_unicode_with_escapes, so decode errors report the positions CPython does
+func escapeNonASCII(body string) []byte {
+	escaped := make([]byte, 0, len(body))
+	for i := 0; i < len(body); {
+		if body[i] == '\\' {
+			escaped = append(escaped, '\\')
+			i++
+			// A backslash before a non-ASCII character stays a backslash
+			if i < len(body) && body[i] >= utf8.RuneSelf {
+				escaped = append(escaped, "u005c"...)
+			}
+		}
+		if i >= len(body) {
+			break
+		}
+		if body[i] >= utf8.RuneSelf {
+			r, size := utf8.DecodeRuneInString(body[i:])
+			escaped = append(escaped, fmt.Sprintf(`\U%08x`, r)...)
+			i += size
+		} else {
+			escaped = append(escaped, body[i])
+			i++
+		}
+	}
+	return escaped
+}
+
+// decodeUnicodeEscape decodes an ASCII str body like the unicode_escape codec
+func decodeUnicodeEscape(s []byte) ([]byte, error) {
+	value := make([]byte, 0, len(s))
+	for i := 0; i < len(s); {
+		if s[i] != '\\' {
+			value = append(value, s[i])
+			i++
+			continue
+		}
+
+		start := i
+		i++
+		if i >= len(s) {
+			return nil, &UnicodeDecodeError{"unicodeescape", s, start, len(s), `\ at end of string`}
+		}
+		c := s[i]
+		i++
+		if simple, isSimple := simpleEscape(c); isSimple {
+			value = append(value, simple...)
+			continue
+		}
+
+		switch c {
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			code := rune(c - '0')
+			for digits := 1; digits < 3 && i < len(s) && isOctal(s[i]); digits++ {
+				code = code*8 + rune(s[i]-'0')
+				i++
+			}
+			value = appendCodePoint(value, code)
+		case 'x', 'u', 'U':
+			digits := map[byte]int{'x': 2, 'u': 4, 'U': 8}[c]
+			var code rune
+			for ; digits > 0; digits-- {
+				if i >= len(s) || !isHexDigit(s[i]) {
+					format := map[byte]string{'x': `\xXX`, 'u': `\uXXXX`, 'U': `\UXXXXXXXX`}[c]
+					return nil, &UnicodeDecodeError{"unicodeescape", s, start, i, "truncated " + format + " escape"}
+				}
+				code = code*16 + rune(hexDigitValue(s[i]))
+				i++
+			}
+			if code > utf8.MaxRune {
+				return nil, &UnicodeDecodeError{"unicodeescape", s, start, i, "illegal Unicode character"}
+			}
+			value = appendCodePoint(value, code)
+		case 'N':
+			reason := `malformed \N character escape`
+			if i < len(s) && s[i] == '{' {
+				i++
+				end := i
+				for end < len(s) && s[end] != '}' {
+					end++
+				}
+				if end > i && end < len(s) {
+					name := string(s[i:end])
+					i = end + 1
+					if code, ok := lookupUnicodeName(name); ok {
+						value = appendCodePoint(value, code)
+						continue
+					}
+					reason = "unknown Unicode character name"
+				} else {
+					i = end
+				}
+			}
+			return nil, &UnicodeDecodeError{"unicodeescape", s, start, i, reason}
+		default:
+			// Unknown escapes are kept as written
+			value = append(value, '\\', c)
+		}
+	}
+	return value, nil
+}
+
+// decodeBytesEscape decodes a bytes body like _PyBytes_DecodeEscape, \u, \U and \N have no meaning in bytes
+func decodeBytesEscape(s string) ([]byte, error) {
+	value := make([]byte, 0, len(s))
+	for i := 0; i < len(s); {
+		if s[i] != '\\' {
+			value = append(value, s[i])
+			i++
+			continue
+		}
+
+		start := i
+		i++
+		if i >= len(s) {
+			return nil, &ValueError{Literal: s, Message: `Trailing \ in string`}
+		}
+		c := s[i]
+		i++
+		if simple, isSimple := simpleEscape(c); isSimple {
+			value = append(value, simple...)
+			continue
+		}
+
+		switch c {
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			// Values above \377 wrap around
+			code := c - '0'
+			for digits := 1; digits < 3 && i < len(s) && isOctal(s[i]); digits++ {
+				code = code*8 + s[i] - '0'
+				i++
+			}
+			value = append(value, code)
+		case 'x':
+			if i+1 >= len(s) || !isHexDigit(s[i]) || !isHexDigit(s[i+1]) {
+				return nil, &ValueError{Literal: s, Message: fmt.Sprintf(`invalid \x escape at position %d`, start)}
+			}
+			value = append(value, hexDigitValue(s[i])<<4|hexDigitValue(s[i+1]))
+			i += 2
+		default:
+			value = append(value, '\\', c)
+		}
+	}
+	return value, nil
+}
+
+// simpleEscape returns what a single character escape stands for, a backslash before a newline continues the literal
+func simpleEscape(c byte) (string, bool) {
+	switch c {
+	case '\n':
+		return "", true
+	case '\\', '\'', '"':
+		return string(c), true
+	case 'a':
+		return "\a", true
+	case 'b':
+		return "\b", true
+	case 'f':
+		return "\f", true
+	case 'n':
+		return "\n", true
+	case 'r':
+		return "\r", true
+	case 't':
+		return "\t", true
+	case 'v':
+		return "\v", true
+	}
+	return "", false
+}
+
+// appendCodePoint appends code as UTF-8, keeping a lone surrogate in its three byte form, which utf8.AppendRune would replace
+func appendCodePoint(value []byte, code rune) []byte {
+	if code >= 0xd800 && code <= 0xdfff {
+		return append(value, 0xe0|byte(code>>12), 0x80|byte(code>>6)&0x3f, 0x80|byte(code)&0x3f)
+	}
+	return utf8.AppendRune(value, code)
+}
+
+func isOctal(c byte) bool {
+	return c >= '0' && c <= '7'
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func hexDigitValue(c byte) byte {
+	switch {
+	case c >= 'a':
+		return c - 'a' + 10
+	case c >= 'A':
+		return c - 'A' + 10
+	}
+	return c - '0'
+}
@@ -0,0 +1,110 @@
+package ast
+
+import (
+	"testing"
+
+	"github.com/brettlangdon/gython/grammar"
+	"github.com/brettlangdon/gython/scanner"
+)
+
+func TestParseString(t *testing.T) {
+	tests := []struct {
+		literal string
+		value   string
+		isBytes bool
+	}{
+		{`'plain'`, "plain", false},
+		{`'a\nb\tc\\d\'e\"f'`, "a\nb\tc\\d'e\"f", false},
+		{`'\a\b\f\v\r'`, "\a\b\f\v\r", false},
+		{"'line\\\ncontinued'", "linecontinued", false},
+		{`'''triple\n'''`, "triple\n", false},
+		// Octal escapes take up to three digits
+		{`'\0\12\101\1011\777'`, "\x00\nAA1ǿ", false},
+		{`'\x41\xe9'`, "Aé", false},
+		{`'é€𐀀'`, "é€𐀀", false},
+		{`'\U0001F600'`, "\U0001F600", false},
+		{`'\N{SNOWMAN}\N{latin small letter e with acute}'`, "☃é", false},
+		{`'\N{LATIN CAPITAL LETTER GHA}'`, "Ƣ", false},
+		// The names are those of Unicode 8.0.0, like Python 3.5
+		{`'\N{CHEROKEE SMALL LETTER YE}'`, "\u13F8", false},
+		{`'\N{HANGUL SYLLABLE GAG}\N{CJK UNIFIED IDEOGRAPH-4E00}'`, "각一", false},
+		// Lone surrogates keep their three byte form, a pair of them is not combined
+		{`'\ud800'`, "\xed\xa0\x80", false},
+		{`'\ud83d\ude00'`, "\xed\xa0\xbd\xed\xb8\x80", false},
+		// Unknown escapes and a backslash before a non-ASCII character are kept
+		{`'\q\%'`, `\q\%`, false},
+		{`'é\é'`, `é\é`, false},
+		{`'café \x41'`, "café A", false},
+		{`u'\x41'`, "A", false},
+		{`R'\x41\n'`, `\x41\n`, false},
+		{`b'\x41\xff\0\777\n\q'`, "A\xff\x00\xff\n\\q", true},
+		{`rb'\x41'`, `\x41`, true},
+		{`b'A\N{SNOWMAN}'`, `A\N{SNOWMAN}`, true},
+	}
+	for _, test := range tests {
+		value, isBytes, err := ParseString(test.literal)
+		if err != nil || string(value) != test.value || isBytes != test.isBytes {
+			t.Errorf("ParseString(%s) = %q, %v, %v, want %q, %v", test.literal, value, isBytes, err, test.value, test.isBytes)
+		}
+	}
+}
+
+func TestParseStringErrors(t *testing.T) {
+	tests := []struct {
+		literal string
+		err     string
+	}{
+		{`B'é'`, "bytes can only contain ASCII literal characters."},
+		{`b'\x4'`, `invalid \x escape at position 0`},
+		{`b'\xg0'`, `invalid \x escape at position 0`},
+		{`'\x4'`, `'unicodeescape' codec can't decode bytes in position 0-2: truncated \xXX escape`},
+		{`'\u12'`, `'unicodeescape' codec can't decode bytes in position 0-3: truncated \uXXXX escape`},
+		{`'\U0001F60'`, `'unicodeescape' codec can't decode bytes in position 0-8: truncated \UXXXXXXXX escape`},
+		{`'\U0011ffff'`, `'unicodeescape' codec can't decode bytes in position 0-9: illegal Unicode character`},
+		{`'\N{NOT A REAL NAME}'`, `'unicodeescape' codec can't decode bytes in position 0-18: unknown Unicode character name`},
+		{`'\N{OSAGE CAPITAL LETTER A}'`, `'unicodeescape' codec can't decode bytes in position 0-25: unknown Unicode character name`},
+		{`'\N'`, `'unicodeescape' codec can't decode bytes in position 0-1: malformed \N character escape`},
+		{`'\N{}'`, `'unicodeescape' codec can't decode bytes in position 0-2: malformed \N character escape`},
+		{`'\N{SNOWMAN'`, `'unicodeescape' codec can't decode bytes in position 0-9: malformed \N character escape`},
+		// Positions count a non-ASCII character as the ten bytes of its \U escape, like CPython
+		{`'é\x4'`, `'unicodeescape' codec can't decode bytes in position 10-12: truncated \xXX escape`},
+	}
+	for _, test := range tests {
+		value, _, err := ParseString(test.literal)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("ParseString(%s) = %q, %v, want the error %q", test.literal, value, err, test.err)
+		}
+	}
+}
+
+func TestFormattedStringsRejected(t *testing.T) {
+	tests := []struct {
+		source    string
+		colOffset int
+	}{
+		{"x = f'a{b}'\n", 4},
+		{"x = 'a' F\"{b}\"\n", 8},
+		{"x = rf'{a}'\n", 4},
+	}
+	for _, test := range tests {
+		source := test.source
+		tokenizer := scanner.NewBytesScanner([]byte(source))
+		tokenizer.SetVersion(scanner.Python36)
+		gp := grammar.NewGrammarParser(tokenizer)
+		start := gp.Parse()
+		if len(gp.Errors) > 0 {
+			t.Fatalf("parsing %q: %s", source, gp.Errors[0])
+		}
+		mod, err := ASTFromGrammar(start)
+		syntaxErr, isSyntaxErr := err.(*SyntaxError)
+		if !isSyntaxErr || syntaxErr.Message != "f-strings are not supported by the Python 3.5 AST" {
+			t.Errorf("ASTFromGrammar(%q) = %v, %v, want an f-string SyntaxError", source, mod, err)
+		} else if syntaxErr.Lineno != 1 || syntaxErr.ColOffset != test.colOffset {
+			t.Errorf("ASTFromGrammar(%q) placed the error at %d:%d", source, syntaxErr.Lineno, syntaxErr.ColOffset)
+		}
+	}
+
+	if _, _, err := ParseString("f'a{b}'"); err == nil {
+		t.Errorf("ParseString decoded an f-string")
+	}
+}
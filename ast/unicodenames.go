@@ -0,0 +1,115 @@
+package ast
+
+//go:generate sh -c "python3 ../tools/unicodenames.py https://www.unicode.org/Public/8.0.0/ucd > unicodenames_table.go"
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	unicodeNamesOnce   sync.Once
+	unicodeNamesLookup map[string]rune
+)
+
+// The short jamo names a Hangul syllable name is built from, in the order of their code points
+var (
+	hangulLeading  = []string{"G", "GG", "N", "D", "DD", "R", "M", "B", "BB", "S", "SS", "", "J", "JJ", "C", "K", "T", "P", "H"}
+	hangulVowels   = []string{"A", "AE", "YA", "YAE", "EO", "E", "YEO", "YE", "O", "WA", "WAE", "OE", "YO", "U", "WEO", "WE", "WI", "YU", "EU", "YI", "I"}
+	hangulTrailing = []string{"", "G", "GG", "GS", "N", "NJ", "NH", "D", "L", "LG", "LM", "LB", "LS", "LT", "LP", "LH", "M", "B", "BS", "S", "SS", "NG", "J", "C", "K", "T", "P", "H"}
+)
+
+const hangulSyllableBase = 0xAC00
+
+// loadUnicodeNames builds the name lookup from the generated tables
+func loadUnicodeNames() {
+	unicodeNamesLookup = make(map[string]rune)
+	var code rune
+	for _, line := range strings.Split(unicodeNames, "\n") {
+		switch {
+		case line == "":
+		case line[0] == '@':
+			value, _ := strconv.ParseUint(line[1:], 16, 32)
+			code = rune(value)
+		default:
+			unicodeNamesLookup[line] = code
+			code++
+		}
+	}
+
+	for _, line := range strings.Split(unicodeNameAliases, "\n") {
+		if space := strings.IndexByte(line, ' '); space > 0 {
+			value, _ := strconv.ParseUint(line[:space], 16, 32)
+			unicodeNamesLookup[line[space+1:]] = rune(value)
+		}
+	}
+}
+
+// lookupUnicodeName returns the character named by a \N{name} escape, like _getcode in
+// unicodedata. Names and aliases are matched ignoring case, but the algorithmic
+// CJK UNIFIED IDEOGRAPH-XXXX and HANGUL SYLLABLE names must be upper case.
+func lookupUnicodeName(name string) (rune, bool) {
+	if strings.HasPrefix(name, "HANGUL SYLLABLE ") {
+		return lookupHangulSyllable(name[len("HANGUL SYLLABLE "):])
+	}
+	if strings.HasPrefix(name, "CJK UNIFIED IDEOGRAPH-") {
+		return lookupUnifiedIdeograph(name[len("CJK UNIFIED IDEOGRAPH-"):])
+	}
+
+	unicodeNamesOnce.Do(loadUnicodeNames)
+	code, ok := unicodeNamesLookup[strings.ToUpper(name)]
+	return code, ok
+}
+
+// lookupHangulSyllable composes a syllable from the longest matching jamo names
+func lookupHangulSyllable(name string) (rune, bool) {
+	leading, length := findJamo(name, hangulLeading)
+	name = name[length:]
+	vowel, length := findJamo(name, hangulVowels)
+	name = name[length:]
+	trailing, length := findJamo(name, hangulTrailing)
+	if leading < 0 || vowel < 0 || trailing < 0 || length != len(name) {
+		return 0, false
+	}
+	code := (leading*len(hangulVowels)+vowel)*len(hangulTrailing) + trailing
+	return rune(hangulSyllableBase + code), true
+}
+
+// findJamo returns the index and length of the longest jamo name starting name, or -1 when none does
+func findJamo(name string, jamo []string) (int, int) {
+	index, length := -1, -1
+	for i, short := range jamo {
+		if len(short) > length && strings.HasPrefix(name, short) {
+			index, length = i, len(short)
+		}
+	}
+	if length < 0 {
+		length = 0
+	}
+	return index, length
+}
+
+// lookupUnifiedIdeograph parses the 4 or 5 upper case hex digits of an ideograph name
+func lookupUnifiedIdeograph(digits string) (rune, bool) {
+	if len(digits) != 4 && len(digits) != 5 {
+		return 0, false
+	}
+	var code rune
+	for _, digit := range digits {
+		switch {
+		case digit >= '0' && digit <= '9':
+			code = code*16 + digit - '0'
+		case digit >= 'A' && digit <= 'F':
+			code = code*16 + digit - 'A' + 10
+		default:
+			return 0, false
+		}
+	}
+	for _, ideographs := range unifiedIdeographs {
+		if code >= ideographs[0] && code <= ideographs[1] {
+			return code, true
+		}
+	}
+	return 0, false
+}
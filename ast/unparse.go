@@ -0,0 +1,599 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brettlangdon/gython/gython"
+)
+
+// Precedences of the expression forms, from the loosest binding to the tightest. A tuple with a
+// starred element only goes unparenthesized where the grammar has testlist_star_expr or exprlist,
+// and a yield where a whole testlist may also hold a yield, like an assigned value.
+const (
+	precStarTuple = iota
+	precYield
+	precTuple
+	precTest
+	precOr
+	precAnd
+	precNot
+	precCompare
+	precBitOr
+	precBitXor
+	precBitAnd
+	precShift
+	precArith
+	precTerm
+	precFactor
+	precPower
+	precAwait
+	precAtom
+)
+
+// Unparse returns Python 3.5 source for mod, with only the parentheses needed to parse back into the same tree
+func Unparse(mod Mod) string {
+	unparser := &unparser{}
+	switch mod := mod.(type) {
+	case *Module:
+		unparser.statements(mod.Body)
+	}
+	return unparser.source.String()
+}
+
+// unparser writes the source of a tree, one statement per line
+type unparser struct {
+	source strings.Builder
+	indent int
+}
+
+// line starts a new line at the current indentation
+func (unparser *unparser) line(parts ...string) {
+	unparser.source.WriteString(strings.Repeat("    ", unparser.indent))
+	for _, part := range parts {
+		unparser.source.WriteString(part)
+	}
+	unparser.source.WriteString("\n")
+}
+
+// block writes a compound statement header followed by its indented body
+func (unparser *unparser) block(header string, body []Statement) {
+	unparser.line(header, ":")
+	unparser.indent++
+	unparser.statements(body)
+	unparser.indent--
+}
+
+// definition writes the decorators of a function or class, after a blank line unless it starts the source
+func (unparser *unparser) definition(decorators []Expression) {
+	if unparser.source.Len() > 0 {
+		unparser.source.WriteString("\n")
+	}
+	for _, decorator := range decorators {
+		unparser.line("@", unparser.expression(decorator, precAtom))
+	}
+}
+
+func (unparser *unparser) statements(stmts []Statement) {
+	for _, stmt := range stmts {
+		unparser.statement(stmt)
+	}
+}
+
+func (unparser *unparser) statement(stmt Statement) {
+	switch stmt := stmt.(type) {
+	case *FunctionDef:
+		unparser.definition(stmt.DecoratorList)
+		unparser.block(unparser.functionHeader("def ", stmt.Name, stmt.Args, stmt.Returns), stmt.Body)
+	case *AsyncFunctionDef:
+		unparser.definition(stmt.DecoratorList)
+		unparser.block(unparser.functionHeader("async def ", stmt.Name, stmt.Args, stmt.Returns), stmt.Body)
+	case *ClassDef:
+		unparser.definition(stmt.DecoratorList)
+		header := "class " + stmt.Name.String()
+		if len(stmt.Bases) > 0 || len(stmt.Keywords) > 0 {
+			header += "(" + unparser.callArguments(stmt.Bases, stmt.Keywords) + ")"
+		}
+		unparser.block(header, stmt.Body)
+	case *Return:
+		if stmt.Value == nil {
+			unparser.line("return")
+		} else {
+			unparser.line("return ", unparser.expression(stmt.Value, precTuple))
+		}
+	case *Delete:
+		unparser.line("del ", unparser.join(stmt.Targets, precBitOr))
+	case *Assign:
+		targets := make([]string, 0, len(stmt.Targets)+1)
+		for _, target := range stmt.Targets {
+			targets = append(targets, unparser.expression(target, precStarTuple))
+		}
+		targets = append(targets, unparser.expression(stmt.Value, precStarTuple))
+		unparser.line(strings.Join(targets, " = "))
+	case *AugAssign:
+		unparser.line(
+			unparser.expression(stmt.Target, precTuple), " ", binaryOperatorText(stmt.Operator), "= ",
+			unparser.expression(stmt.Value, precYield),
+		)
+	case *For:
+		unparser.forStatement("for ", stmt.Target, stmt.Iter, stmt.Body, stmt.OrElse)
+	case *AsyncFor:
+		unparser.forStatement("async for ", stmt.Target, stmt.Iter, stmt.Body, stmt.OrElse)
+	case *While:
+		unparser.block("while "+unparser.expression(stmt.Test, precTest), stmt.Body)
+		unparser.orElse(stmt.OrElse)
+	case *If:
+		unparser.block("if "+unparser.expression(stmt.Test, precTest), stmt.Body)
+		orElse := stmt.OrElse
+		// An else holding nothing but another if is written as elif
+		for len(orElse) == 1 {
+			elif, isIf := orElse[0].(*If)
+			if !isIf {
+				break
+			}
+			unparser.block("elif "+unparser.expression(elif.Test, precTest), elif.Body)
+			orElse = elif.OrElse
+		}
+		unparser.orElse(orElse)
+	case *With:
+		unparser.block("with "+unparser.withItems(stmt.Items), stmt.Body)
+	case *AsyncWith:
+		unparser.block("async with "+unparser.withItems(stmt.Items), stmt.Body)
+	case *Raise:
+		switch {
+		case stmt.Exc == nil:
+			unparser.line("raise")
+		case stmt.Cause == nil:
+			unparser.line("raise ", unparser.expression(stmt.Exc, precTest))
+		default:
+			unparser.line("raise ", unparser.expression(stmt.Exc, precTest), " from ", unparser.expression(stmt.Cause, precTest))
+		}
+	case *Try:
+		unparser.block("try", stmt.Body)
+		for _, handler := range stmt.Handlers {
+			header := "except"
+			if handler.Type != nil {
+				header += " " + unparser.expression(handler.Type, precTest)
+			}
+			if handler.Name != nil {
+				header += " as " + handler.Name.String()
+			}
+			unparser.block(header, handler.Body)
+		}
+		unparser.orElse(stmt.OrElse)
+		if len(stmt.FinalBody) > 0 {
+			unparser.block("finally", stmt.FinalBody)
+		}
+	case *Assert:
+		if stmt.Message == nil {
+			unparser.line("assert ", unparser.expression(stmt.Test, precTest))
+		} else {
+			unparser.line("assert ", unparser.expression(stmt.Test, precTest), ", ", unparser.expression(stmt.Message, precTest))
+		}
+	case *Import:
+		unparser.line("import ", unparseAliases(stmt.Names))
+	case *ImportFrom:
+		module := strings.Repeat(".", stmt.Level)
+		if stmt.Module != nil {
+			module += stmt.Module.String()
+		}
+		unparser.line("from ", module, " import ", unparseAliases(stmt.Names))
+	case *Global:
+		unparser.line("global ", unparseIdentifiers(stmt.Names))
+	case *Nonlocal:
+		unparser.line("nonlocal ", unparseIdentifiers(stmt.Names))
+	case *Expr:
+		unparser.line(unparser.expression(stmt.Value, precStarTuple))
+	case *Pass:
+		unparser.line("pass")
+	case *Break:
+		unparser.line("break")
+	case *Continue:
+		unparser.line("continue")
+	}
+}
+
+func (unparser *unparser) forStatement(keyword string, target Expression, iter Expression, body []Statement, orElse []Statement) {
+	header := keyword + unparser.expression(target, precStarTuple) + " in " + unparser.expression(iter, precTuple)
+	unparser.block(header, body)
+	unparser.orElse(orElse)
+}
+
+func (unparser *unparser) orElse(orElse []Statement) {
+	if len(orElse) > 0 {
+		unparser.block("else", orElse)
+	}
+}
+
+func (unparser *unparser) functionHeader(keyword string, name *gython.Unicode, args *Arguments, returns Expression) string {
+	header := keyword + name.String() + "(" + unparser.arguments(args, true) + ")"
+	if returns != nil {
+		header += " -> " + unparser.expression(returns, precTest)
+	}
+	return header
+}
+
+// arguments returns the parameters of a function, or of a lambda which cannot have annotations
+func (unparser *unparser) arguments(args *Arguments, annotated bool) string {
+	if args == nil {
+		return ""
+	}
+	params := make([]string, 0)
+	// The defaults belong to the last positional arguments
+	firstDefault := len(args.Args) - len(args.Defaults)
+	for i, positional := range args.Args {
+		var value Expression
+		if i >= firstDefault {
+			value = args.Defaults[i-firstDefault]
+		}
+		params = append(params, unparser.parameter(positional, value, annotated))
+	}
+	if args.VarArg != nil {
+		params = append(params, "*"+unparser.parameter(args.VarArg, nil, annotated))
+	} else if len(args.KwOnlyArgs) > 0 {
+		params = append(params, "*")
+	}
+	for i, kwOnly := range args.KwOnlyArgs {
+		var value Expression
+		if i < len(args.KwDefaults) {
+			value = args.KwDefaults[i]
+		}
+		params = append(params, unparser.parameter(kwOnly, value, annotated))
+	}
+	if args.KwArg != nil {
+		params = append(params, "**"+unparser.parameter(args.KwArg, nil, annotated))
+	}
+	return strings.Join(params, ", ")
+}
+
+// parameter returns one parameter with its annotation and default, spacing the
+// equals sign only after an annotation as PEP 8 does
+func (unparser *unparser) parameter(arg *Arg, value Expression, annotated bool) string {
+	param := arg.Arg.String()
+	equals := "="
+	if annotated && arg.Annotation != nil {
+		param += ": " + unparser.expression(arg.Annotation, precTest)
+		equals = " = "
+	}
+	if value != nil {
+		param += equals + unparser.expression(value, precTest)
+	}
+	return param
+}
+
+func (unparser *unparser) withItems(items []*WithItem) string {
+	parts := make([]string, 0, len(items))
+	for _, item := range items {
+		part := unparser.expression(item.ContextExpr, precTest)
+		if item.OptionalVars != nil {
+			part += " as " + unparser.expression(item.OptionalVars, precBitOr)
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func unparseAliases(aliases []*Alias) string {
+	parts := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		part := alias.Name.String()
+		if alias.AsName != nil {
+			part += " as " + alias.AsName.String()
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func unparseIdentifiers(identifiers []*gython.Unicode) string {
+	parts := make([]string, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		parts = append(parts, identifier.String())
+	}
+	return strings.Join(parts, ", ")
+}
+
+// join returns exprs separated by commas, each parenthesized below precedence
+func (unparser *unparser) join(exprs []Expression, precedence int) string {
+	parts := make([]string, 0, len(exprs))
+	for _, expr := range exprs {
+		parts = append(parts, unparser.expression(expr, precedence))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// expression returns the source of expr, parenthesized when it binds looser than precedence
+func (unparser *unparser) expression(expr Expression, precedence int) string {
+	source, exprPrecedence := unparser.expressionSource(expr)
+	if exprPrecedence < precedence {
+		return "(" + source + ")"
+	}
+	return source
+}
+
+// expressionSource returns the unparenthesized source of expr and how tightly it binds
+func (unparser *unparser) expressionSource(expr Expression) (string, int) {
+	switch expr := expr.(type) {
+	case *BoolOp:
+		keyword, precedence := " or ", precOr
+		if _, isAnd := expr.Operator.(*And); isAnd {
+			keyword, precedence = " and ", precAnd
+		}
+		// Nested operations of the same kind keep their parentheses, the parser would flatten them
+		values := make([]string, 0, len(expr.Values))
+		for _, value := range expr.Values {
+			values = append(values, unparser.expression(value, precedence+1))
+		}
+		return strings.Join(values, keyword), precedence
+	case *BinOp:
+		precedence := binaryOperatorPrecedence(expr.Operator)
+		left, right := precedence, precedence+1
+		// ** is right associative, and its right operand may be a unary operation
+		if _, isPow := expr.Operator.(*Pow); isPow {
+			left, right = precAwait, precFactor
+		}
+		return unparser.expression(expr.Left, left) + " " + binaryOperatorText(expr.Operator) + " " + unparser.expression(expr.Right, right), precedence
+	case *UnaryOp:
+		switch expr.Operator.(type) {
+		case *Not:
+			return "not " + unparser.expression(expr.Operand, precNot), precNot
+		case *Invert:
+			return "~" + unparser.expression(expr.Operand, precFactor), precFactor
+		case *UAdd:
+			return "+" + unparser.expression(expr.Operand, precFactor), precFactor
+		default:
+			return "-" + unparser.expression(expr.Operand, precFactor), precFactor
+		}
+	case *Lambda:
+		params := unparser.arguments(expr.Args, false)
+		if params != "" {
+			params = " " + params
+		}
+		return "lambda" + params + ": " + unparser.expression(expr.Body, precTest), precTest
+	case *IfExp:
+		return unparser.expression(expr.Body, precOr) + " if " + unparser.expression(expr.Test, precOr) +
+			" else " + unparser.expression(expr.OrElse, precTest), precTest
+	case *Dict:
+		items := make([]string, 0, len(expr.Keys))
+		for i, key := range expr.Keys {
+			if key == nil {
+				items = append(items, "**"+unparser.expression(expr.Values[i], precBitOr))
+			} else {
+				items = append(items, unparser.expression(key, precTest)+": "+unparser.expression(expr.Values[i], precTest))
+			}
+		}
+		return "{" + strings.Join(items, ", ") + "}", precAtom
+	case *Set:
+		if len(expr.Elements) == 0 {
+			// There is no literal for an empty set, but unpacking an empty tuple makes one
+			return "{*()}", precAtom
+		}
+		return "{" + unparser.join(expr.Elements, precTest) + "}", precAtom
+	case *ListComp:
+		return "[" + unparser.expression(expr.Element, precTest) + unparser.comprehensions(expr.Generators) + "]", precAtom
+	case *SetComp:
+		return "{" + unparser.expression(expr.Element, precTest) + unparser.comprehensions(expr.Generators) + "}", precAtom
+	case *DictComp:
+		item := unparser.expression(expr.Key, precTest) + ": " + unparser.expression(expr.Value, precTest)
+		return "{" + item + unparser.comprehensions(expr.Generators) + "}", precAtom
+	case *GeneratorExp:
+		return "(" + unparser.expression(expr.Element, precTest) + unparser.comprehensions(expr.Generators) + ")", precAtom
+	case *Await:
+		return "await " + unparser.expression(expr.Value, precAtom), precAwait
+	case *Yield:
+		if expr.Value == nil {
+			return "yield", precYield
+		}
+		return "yield " + unparser.expression(expr.Value, precTuple), precYield
+	case *YieldFrom:
+		return "yield from " + unparser.expression(expr.Value, precTest), precYield
+	case *Compare:
+		parts := []string{unparser.expression(expr.Left, precCompare+1)}
+		for i, op := range expr.Operators {
+			parts = append(parts, compareOperatorText(op), unparser.expression(expr.Comparators[i], precCompare+1))
+		}
+		return strings.Join(parts, " "), precCompare
+	case *Call:
+		function := unparser.expression(expr.Function, precAtom)
+		// A lone generator expression argument needs no parentheses of its own
+		if len(expr.Args) == 1 && len(expr.Keywords) == 0 {
+			if generator, isGenerator := expr.Args[0].(*GeneratorExp); isGenerator {
+				return function + unparser.expression(generator, precAtom), precAtom
+			}
+		}
+		return function + "(" + unparser.callArguments(expr.Args, expr.Keywords) + ")", precAtom
+	case *Num:
+		return unparseNumber(expr.Value)
+	case *Str:
+		return expr.Value.Repr(), precAtom
+	case *Bytes:
+		return expr.Value.Repr(), precAtom
+	case *NameConstant:
+		return gython.Repr(expr.Value), precAtom
+	case *Ellipsis:
+		return "...", precAtom
+	case *Attribute:
+		value := unparser.expression(expr.Value, precAtom)
+		// The dot after an integer would be read as a decimal point
+		if num, isNum := expr.Value.(*Num); isNum {
+			if _, isLong := num.Value.(*gython.Long); isLong && !strings.HasPrefix(value, "(") {
+				value += " "
+			}
+		}
+		return value + "." + expr.Attr.String(), precAtom
+	case *Subscript:
+		return unparser.expression(expr.Value, precAtom) + "[" + unparser.slice(expr.Slice, true) + "]", precAtom
+	case *Starred:
+		return "*" + unparser.expression(expr.Value, precBitOr), precBitOr
+	case *Name:
+		return expr.Identifier.String(), precAtom
+	case *List:
+		return "[" + unparser.join(expr.Elements, precTest) + "]", precAtom
+	case *Tuple:
+		precedence := precTuple
+		for _, element := range expr.Elements {
+			if _, isStarred := element.(*Starred); isStarred {
+				precedence = precStarTuple
+			}
+		}
+		switch len(expr.Elements) {
+		case 0:
+			return "()", precAtom
+		case 1:
+			return unparser.expression(expr.Elements[0], precTest) + ",", precedence
+		}
+		return unparser.join(expr.Elements, precTest), precedence
+	}
+	return "", precAtom
+}
+
+func (unparser *unparser) comprehensions(generators []*Comprehension) string {
+	var source strings.Builder
+	for _, generator := range generators {
+		source.WriteString(" for " + unparser.expression(generator.Target, precStarTuple))
+		source.WriteString(" in " + unparser.expression(generator.Iter, precOr))
+		for _, test := range generator.Ifs {
+			source.WriteString(" if " + unparser.expression(test, precOr))
+		}
+	}
+	return source.String()
+}
+
+func (unparser *unparser) callArguments(args []Expression, keywords []*Keyword) string {
+	parts := make([]string, 0, len(args)+len(keywords))
+	for _, arg := range args {
+		if starred, isStarred := arg.(*Starred); isStarred {
+			parts = append(parts, "*"+unparser.expression(starred.Value, precTest))
+		} else {
+			parts = append(parts, unparser.expression(arg, precTest))
+		}
+	}
+	for _, keyword := range keywords {
+		if keyword.Arg == nil {
+			parts = append(parts, "**"+unparser.expression(keyword.Value, precTest))
+		} else {
+			parts = append(parts, keyword.Arg.String()+"="+unparser.expression(keyword.Value, precTest))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// slice returns the source of a subscript, a tuple index needs no parentheses at the top level
+func (unparser *unparser) slice(slice SliceNode, topLevel bool) string {
+	switch slice := slice.(type) {
+	case *Index:
+		if topLevel {
+			return unparser.expression(slice.Value, precTuple)
+		}
+		return unparser.expression(slice.Value, precTest)
+	case *Slice:
+		source := ""
+		if slice.Lower != nil {
+			source += unparser.expression(slice.Lower, precTest)
+		}
+		source += ":"
+		if slice.Upper != nil {
+			source += unparser.expression(slice.Upper, precTest)
+		}
+		if slice.Step != nil {
+			source += ":" + unparser.expression(slice.Step, precTest)
+		}
+		return source
+	case *ExtSlice:
+		dims := make([]string, 0, len(slice.Dimensions))
+		for _, dim := range slice.Dimensions {
+			dims = append(dims, unparser.slice(dim, false))
+		}
+		if len(dims) == 1 {
+			return dims[0] + ","
+		}
+		return strings.Join(dims, ", ")
+	}
+	return ""
+}
+
+// unparseNumber returns the literal of a number, a negative one binds like a unary minus
+func unparseNumber(value gython.Object) (string, int) {
+	// Infinity has no literal, but a float too large to represent rounds to it
+	source := strings.Replace(gython.Repr(value), "inf", "1e309", -1)
+	if strings.HasPrefix(source, "-") {
+		return source, precFactor
+	}
+	return source, precAtom
+}
+
+func binaryOperatorPrecedence(op Operator) int {
+	switch op.(type) {
+	case *BitOr:
+		return precBitOr
+	case *BitXor:
+		return precBitXor
+	case *BitAnd:
+		return precBitAnd
+	case *LShift, *RShift:
+		return precShift
+	case *Add, *Sub:
+		return precArith
+	case *Pow:
+		return precPower
+	}
+	return precTerm
+}
+
+func binaryOperatorText(op Operator) string {
+	switch op.(type) {
+	case *Add:
+		return "+"
+	case *Sub:
+		return "-"
+	case *Mult:
+		return "*"
+	case *MatMult:
+		return "@"
+	case *Div:
+		return "/"
+	case *Modulo:
+		return "%"
+	case *Pow:
+		return "**"
+	case *LShift:
+		return "<<"
+	case *RShift:
+		return ">>"
+	case *BitOr:
+		return "|"
+	case *BitXor:
+		return "^"
+	case *BitAnd:
+		return "&"
+	case *FloorDiv:
+		return "//"
+	}
+	panic(fmt.Sprintf("ast: unknown operator %T", op))
+}
+
+func compareOperatorText(op CompareOperator) string {
+	switch op.(type) {
+	case *Eq:
+		return "=="
+	case *NotEq:
+		return "!="
+	case *Lt:
+		return "<"
+	case *LtE:
+		return "<="
+	case *Gt:
+		return ">"
+	case *GtE:
+		return ">="
+	case *Is:
+		return "is"
+	case *IsNot:
+		return "is not"
+	case *In:
+		return "in"
+	case *NotIn:
+		return "not in"
+	}
+	panic(fmt.Sprintf("ast: unknown comparison operator %T", op))
+}
@@ -0,0 +1,138 @@
+package ast
+
+import (
+	"testing"
+
+	"github.com/brettlangdon/gython/grammar"
+	"github.com/brettlangdon/gython/scanner"
+)
+
+func parseSource(t *testing.T, source string) Mod {
+	gp := grammar.NewGrammarParser(scanner.NewBytesScanner([]byte(source)))
+	start := gp.Parse()
+	if len(gp.Errors) > 0 {
+		t.Fatalf("parsing %q: %s", source, gp.Errors[0])
+	}
+	mod, err := ASTFromGrammar(start)
+	if err != nil {
+		t.Fatalf("parsing %q: %s", source, err)
+	}
+	return mod
+}
+
+// Sources which unparse to themselves, so each one also checks no needless parentheses are added
+var unparseTests = []string{
+	"x = 1\n",
+	"a = b = c, d\n",
+	"a, *b = c\n",
+	"x = *a, *b\n",
+	"*a, b\n",
+	// A tuple with a starred element keeps its parentheses where the grammar has no star_expr
+	"def f():\n    return (*a, *b)\n",
+	"def f():\n    return (*a,)\n",
+	"x[(*a, 1)]\n",
+	"x += (*a, b)\n",
+	"x = yield (*a, b)\n",
+	"for *a, b in (*c, d):\n    pass\n",
+	"x = [a for *a, b in (*c, d)]\n",
+	"x += yield\n",
+	"x = yield a, b\n",
+	"x = yield from y\n",
+	"f((yield))\n",
+	"del a, (b, c), d[0]\n",
+	"x = a + b * c - d / e\n",
+	"x = (a + b) * c\n",
+	"x = a - (b - c)\n",
+	"x = a - b - c\n",
+	"x = a ** b ** c\n",
+	"x = (a ** b) ** c\n",
+	"x = -a ** -b\n",
+	"x = (-a) ** b\n",
+	"x = not a == b\n",
+	"x = (not a) == b\n",
+	"x = a < b < c\n",
+	"x = (a < b) < c\n",
+	"x = a or b and c\n",
+	"x = (a or b) and c\n",
+	"x = a or (b or c)\n",
+	"x = a | b ^ c & d << e\n",
+	"x = ~a @ b // c % d\n",
+	"x = a if b else c if d else e\n",
+	"x = (a if b else c) if d else e\n",
+	"x = lambda: 0\n",
+	"x = lambda a, b=1, *c, d, e=2, **f: (a, b)\n",
+	"x = (lambda: 0) if a else lambda: 1\n",
+	"x = (1, 2)[0]\n",
+	"x = 1,\n",
+	"x = ()\n",
+	"x = [a, *b]\n",
+	"x = {a, *b}\n",
+	"x = {a: b, **c}\n",
+	"x = {**a}\n",
+	"x = {}\n",
+	"x = [a for b, c in d if e if f for g in h]\n",
+	"x = [a for b in (c if d else e)]\n",
+	"x = {a for b in c}\n",
+	"x = {a: b for c in d}\n",
+	"f(a for b in c)\n",
+	"f((a for b in c), d)\n",
+	"f(a, *b, c=d, **e)\n",
+	"f(*a or b)\n",
+	"x = a[b, c]\n",
+	"x = a[b,]\n",
+	"x = a[1:2, 3]\n",
+	"x = a[1:2,]\n",
+	"x = a[::2]\n",
+	"x = a[:]\n",
+	"x = 1 .real\n",
+	"x = 1.5.real\n",
+	"x = 'it\\'s \"quoted\"\\n'\n",
+	"x = b'\\x00\\xff'\n",
+	"x = None, True, False, ...\n",
+	"x = 2j\n",
+	"x = 10000000000000000000000\n",
+	"import a.b as c, d\n",
+	"from . import a\n",
+	"from ..a import b as c, d\n",
+	"def f(a: int = 1, *, b: str, **c) -> None:\n    global d, e\n    return a, b\n",
+	"async def f():\n    async for a in b:\n        await c\n    return await a ** b, (await a)()\n    async with d as e, f:\n        pass\n",
+	"@a.b(c)\nclass A(B, metaclass=C):\n    'docstring'\n\n    @d\n    def f(self):\n        nonlocal e\n",
+	"if a:\n    pass\nelif b:\n    pass\nelse:\n    pass\n",
+	"for a, b in c, d:\n    break\nelse:\n    continue\n",
+	"while a:\n    pass\nelse:\n    pass\n",
+	"try:\n    pass\nexcept A as e:\n    raise B from e\nexcept:\n    raise\nelse:\n    pass\nfinally:\n    pass\n",
+	"with a as (b, c):\n    assert d, e\n",
+}
+
+func TestUnparse(t *testing.T) {
+	for _, source := range unparseTests {
+		if unparsed := Unparse(parseSource(t, source)); unparsed != source {
+			t.Errorf("Unparse(%q) = %q", source, unparsed)
+		}
+	}
+}
+
+func TestUnparseRoundTrip(t *testing.T) {
+	// The unparsed source differs from these, but must parse into the same tree
+	sources := append([]string{
+		"x = ((a))\n",
+		"x = (yield)\n",
+		"x = 'a' 'b'\n",
+		"if a:\n    pass\nelse:\n    if b:\n        pass\n",
+		"f(*(a or b))\n",
+		"x = {'a': 1, 'b': [2, 3], 'c': {4}}\n",
+		"x = -1 ** 2\n",
+		"x = 'caf\\xe9' '\\N{SNOWMAN}\\ud800'\n",
+		"x = 1e400, -1e400j\n",
+		"del (a), [b]\n",
+		"(*a, b) = c\n",
+		"for (*a, b) in c:\n    pass\n",
+	}, unparseTests...)
+	for _, source := range sources {
+		mod := parseSource(t, source)
+		unparsed := Unparse(mod)
+		if want, got := Dump(mod, false), Dump(parseSource(t, unparsed), false); want != got {
+			t.Errorf("%q unparsed to %q\nwant %s\ngot  %s", source, unparsed, want, got)
+		}
+	}
+}
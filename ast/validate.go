@@ -0,0 +1,675 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/brettlangdon/gython/gython"
+)
+
+// ValidationError reports a tree the compiler cannot be trusted with, like the errors of PyAST_Validate
+type ValidationError struct {
+	Node    Node
+	Message string
+}
+
+func (err *ValidationError) Error() string {
+	return err.Message
+}
+
+func invalid(node Node, format string, args ...interface{}) error {
+	return &ValidationError{Node: node, Message: fmt.Sprintf(format, args...)}
+}
+
+// The fields which may be missing, marked with ? in Python's ASDL
+var optionalFields = map[string]bool{
+	"FunctionDef.returns":      true,
+	"AsyncFunctionDef.returns": true,
+	"Return.value":             true,
+	"Raise.exc":                true,
+	"Raise.cause":              true,
+	"Assert.msg":               true,
+	"ImportFrom.module":        true,
+	"Yield.value":              true,
+	"Slice.lower":              true,
+	"Slice.upper":              true,
+	"Slice.step":               true,
+	"ExceptHandler.type":       true,
+	"ExceptHandler.name":       true,
+	"arguments.vararg":         true,
+	"arguments.kwarg":          true,
+	"arg.annotation":           true,
+	"keyword.arg":              true,
+	"alias.asname":             true,
+	"withitem.optional_vars":   true,
+}
+
+// Validate checks the invariants of a tree which the parser guarantees but a tree built by
+// hand or decoded from JSON may break, following PyAST_Validate. It also checks required
+// fields are present and identifiers are valid, as converting a tree from Python objects does.
+func Validate(mod Mod) error {
+	switch mod := mod.(type) {
+	case *Module:
+		if mod == nil {
+			break
+		}
+		return validateStatements(mod.Body)
+	}
+	return invalid(mod, "expected a Module, found %T", mod)
+}
+
+// validateFields checks none of the required fields of node are missing
+func validateFields(node Node) error {
+	name := typeName(node)
+	for _, field := range IterFields(node) {
+		missing := false
+		switch value := field.Value.(type) {
+		case nil:
+			missing = true
+		case Node:
+			missing = isNilNode(value)
+		case *gython.Unicode:
+			missing = value == nil
+		case *gython.Bytes:
+			missing = value == nil
+		}
+		if missing && !optionalFields[name+"."+field.Name] {
+			return invalid(node, "required field %q missing from %s", field.Name, name)
+		}
+	}
+	return nil
+}
+
+func validateStatements(stmts []Statement) error {
+	for _, stmt := range stmts {
+		if stmt == nil {
+			return invalid(nil, "None disallowed in statement list")
+		}
+		if err := validateStatement(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateNonEmpty(node Node, length int, what string, owner string) error {
+	if length == 0 {
+		return invalid(node, "empty %s on %s", what, owner)
+	}
+	return nil
+}
+
+func validateBody(node Node, body []Statement, owner string) error {
+	if err := validateNonEmpty(node, len(body), "body", owner); err != nil {
+		return err
+	}
+	return validateStatements(body)
+}
+
+// validateAll returns the first error of checks, running each only when those before it passed
+func validateAll(checks ...func() error) error {
+	for _, check := range checks {
+		if err := check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateStatement(stmt Statement) error {
+	if err := validateFields(stmt); err != nil {
+		return err
+	}
+
+	switch stmt := stmt.(type) {
+	case *FunctionDef:
+		return validateFunction(stmt, "FunctionDef", stmt.Name, stmt.Args, stmt.Body, stmt.DecoratorList, stmt.Returns)
+	case *AsyncFunctionDef:
+		return validateFunction(stmt, "AsyncFunctionDef", stmt.Name, stmt.Args, stmt.Body, stmt.DecoratorList, stmt.Returns)
+	case *ClassDef:
+		return validateAll(
+			func() error { return validateIdentifier(stmt, stmt.Name) },
+			func() error { return validateBody(stmt, stmt.Body, "ClassDef") },
+			func() error { return validateExpressions(stmt.Bases, NewLoad(), false) },
+			func() error { return validateKeywords(stmt.Keywords) },
+			func() error { return validateExpressions(stmt.DecoratorList, NewLoad(), false) },
+		)
+	case *Return:
+		return validateOptional(stmt.Value)
+	case *Delete:
+		return validateAssignList(stmt, stmt.Targets, NewDel())
+	case *Assign:
+		return validateAll(
+			func() error { return validateAssignList(stmt, stmt.Targets, NewStore()) },
+			func() error { return validateExpression(stmt.Value, NewLoad()) },
+		)
+	case *AugAssign:
+		return validateAll(
+			func() error { return validateExpression(stmt.Target, NewStore()) },
+			func() error { return validateExpression(stmt.Value, NewLoad()) },
+		)
+	case *For:
+		return validateFor(stmt, "For", stmt.Target, stmt.Iter, stmt.Body, stmt.OrElse)
+	case *AsyncFor:
+		return validateFor(stmt, "AsyncFor", stmt.Target, stmt.Iter, stmt.Body, stmt.OrElse)
+	case *While:
+		return validateAll(
+			func() error { return validateExpression(stmt.Test, NewLoad()) },
+			func() error { return validateBody(stmt, stmt.Body, "While") },
+			func() error { return validateStatements(stmt.OrElse) },
+		)
+	case *If:
+		return validateAll(
+			func() error { return validateExpression(stmt.Test, NewLoad()) },
+			func() error { return validateBody(stmt, stmt.Body, "If") },
+			func() error { return validateStatements(stmt.OrElse) },
+		)
+	case *With:
+		return validateWith(stmt, "With", stmt.Items, stmt.Body)
+	case *AsyncWith:
+		return validateWith(stmt, "AsyncWith", stmt.Items, stmt.Body)
+	case *Raise:
+		if stmt.Exc == nil && stmt.Cause != nil {
+			return invalid(stmt, "Raise with cause but no exception")
+		}
+		return validateAll(
+			func() error { return validateOptional(stmt.Exc) },
+			func() error { return validateOptional(stmt.Cause) },
+		)
+	case *Try:
+		if err := validateBody(stmt, stmt.Body, "Try"); err != nil {
+			return err
+		}
+		if len(stmt.Handlers) == 0 && len(stmt.FinalBody) == 0 {
+			return invalid(stmt, "Try has neither except handlers nor finalbody")
+		}
+		if len(stmt.Handlers) == 0 && len(stmt.OrElse) > 0 {
+			return invalid(stmt, "Try has orelse but no except handlers")
+		}
+		for _, handler := range stmt.Handlers {
+			if handler == nil {
+				return invalid(stmt, "None disallowed in handlers of Try")
+			}
+			err := validateAll(
+				func() error { return validateFields(handler) },
+				func() error { return validateOptionalIdentifier(handler, handler.Name) },
+				func() error { return validateOptional(handler.Type) },
+				func() error { return validateBody(handler, handler.Body, "ExceptHandler") },
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return validateAll(
+			func() error { return validateStatements(stmt.FinalBody) },
+			func() error { return validateStatements(stmt.OrElse) },
+		)
+	case *Assert:
+		return validateAll(
+			func() error { return validateExpression(stmt.Test, NewLoad()) },
+			func() error { return validateOptional(stmt.Message) },
+		)
+	case *Import:
+		if err := validateNonEmpty(stmt, len(stmt.Names), "names", "Import"); err != nil {
+			return err
+		}
+		return validateAliases(stmt, stmt.Names, false)
+	case *ImportFrom:
+		if stmt.Level < 0 {
+			return invalid(stmt, "Negative ImportFrom level")
+		}
+		if stmt.Module != nil && !isDottedName(stmt.Module.Value) {
+			return invalid(stmt, "invalid module name %s in ImportFrom", stmt.Module.Repr())
+		}
+		if err := validateNonEmpty(stmt, len(stmt.Names), "names", "ImportFrom"); err != nil {
+			return err
+		}
+		return validateAliases(stmt, stmt.Names, true)
+	case *Global:
+		return validateNames(stmt, stmt.Names, "Global")
+	case *Nonlocal:
+		return validateNames(stmt, stmt.Names, "Nonlocal")
+	case *Expr:
+		return validateExpression(stmt.Value, NewLoad())
+	case *Pass, *Break, *Continue:
+		return nil
+	}
+	return invalid(stmt, "unexpected statement %T", stmt)
+}
+
+func validateFunction(
+	stmt Statement, owner string, name *gython.Unicode, args *Arguments, body []Statement,
+	decorators []Expression, returns Expression,
+) error {
+	return validateAll(
+		func() error { return validateIdentifier(stmt, name) },
+		func() error { return validateBody(stmt, body, owner) },
+		func() error { return validateArguments(args) },
+		func() error { return validateExpressions(decorators, NewLoad(), false) },
+		func() error { return validateOptional(returns) },
+	)
+}
+
+func validateFor(stmt Statement, owner string, target Expression, iter Expression, body []Statement, orElse []Statement) error {
+	return validateAll(
+		func() error { return validateExpression(target, NewStore()) },
+		func() error { return validateExpression(iter, NewLoad()) },
+		func() error { return validateBody(stmt, body, owner) },
+		func() error { return validateStatements(orElse) },
+	)
+}
+
+func validateWith(stmt Statement, owner string, items []*WithItem, body []Statement) error {
+	if err := validateNonEmpty(stmt, len(items), "items", owner); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if item == nil {
+			return invalid(stmt, "None disallowed in items of %s", owner)
+		}
+		err := validateAll(
+			func() error { return validateFields(item) },
+			func() error { return validateExpression(item.ContextExpr, NewLoad()) },
+			func() error {
+				if item.OptionalVars == nil {
+					return nil
+				}
+				return validateExpression(item.OptionalVars, NewStore())
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return validateBody(stmt, body, owner)
+}
+
+func validateAssignList(stmt Statement, targets []Expression, ctx ExpressionContext) error {
+	owner := "Assign"
+	if _, isDel := ctx.(*Del); isDel {
+		owner = "Delete"
+	}
+	if err := validateNonEmpty(stmt, len(targets), "targets", owner); err != nil {
+		return err
+	}
+	return validateExpressions(targets, ctx, false)
+}
+
+// validateAliases checks the names of an import, only an ImportFrom may import *
+func validateAliases(stmt Statement, aliases []*Alias, isFrom bool) error {
+	for _, alias := range aliases {
+		if alias == nil {
+			return invalid(stmt, "None disallowed in names of %s", typeName(stmt))
+		}
+		if err := validateFields(alias); err != nil {
+			return err
+		}
+		name := alias.Name.Value
+		if isFrom && string(name) == "*" && len(aliases) == 1 && alias.AsName == nil {
+			continue
+		}
+		if !isDottedName(name) || (isFrom && strings.Contains(string(name), ".")) {
+			return invalid(alias, "invalid name %s in %s", alias.Name.Repr(), typeName(stmt))
+		}
+		if err := validateOptionalIdentifier(alias, alias.AsName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateNames(stmt Statement, names []*gython.Unicode, owner string) error {
+	if err := validateNonEmpty(stmt, len(names), "names", owner); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := validateIdentifier(stmt, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateKeywords(keywords []*Keyword) error {
+	for _, keyword := range keywords {
+		if keyword == nil {
+			return invalid(nil, "None disallowed in keyword list")
+		}
+		err := validateAll(
+			func() error { return validateFields(keyword) },
+			func() error { return validateOptionalIdentifier(keyword, keyword.Arg) },
+			func() error { return validateExpression(keyword.Value, NewLoad()) },
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateArgs(args []*Arg) error {
+	for _, arg := range args {
+		if err := validateArg(arg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateArg(arg *Arg) error {
+	if arg == nil {
+		return invalid(nil, "None disallowed in arg list")
+	}
+	return validateAll(
+		func() error { return validateFields(arg) },
+		func() error { return validateIdentifier(arg, arg.Arg) },
+		func() error { return validateOptional(arg.Annotation) },
+	)
+}
+
+func validateArguments(args *Arguments) error {
+	if err := validateFields(args); err != nil {
+		return err
+	}
+	if len(args.Defaults) > len(args.Args) {
+		return invalid(args, "more positional defaults than args on arguments")
+	}
+	if len(args.KwDefaults) != len(args.KwOnlyArgs) {
+		return invalid(args, "length of kwonlyargs is not the same as kw_defaults on arguments")
+	}
+	return validateAll(
+		func() error { return validateArgs(args.Args) },
+		func() error {
+			if args.VarArg == nil {
+				return nil
+			}
+			return validateArg(args.VarArg)
+		},
+		func() error { return validateArgs(args.KwOnlyArgs) },
+		func() error {
+			if args.KwArg == nil {
+				return nil
+			}
+			return validateArg(args.KwArg)
+		},
+		func() error { return validateExpressions(args.Defaults, NewLoad(), false) },
+		func() error { return validateExpressions(args.KwDefaults, NewLoad(), true) },
+	)
+}
+
+func validateComprehensions(expr Expression, generators []*Comprehension) error {
+	if len(generators) == 0 {
+		return invalid(expr, "comprehension with no generators")
+	}
+	for _, generator := range generators {
+		if generator == nil {
+			return invalid(expr, "None disallowed in generators of %s", typeName(expr))
+		}
+		err := validateAll(
+			func() error { return validateFields(generator) },
+			func() error { return validateExpression(generator.Target, NewStore()) },
+			func() error { return validateExpression(generator.Iter, NewLoad()) },
+			func() error { return validateExpressions(generator.Ifs, NewLoad(), false) },
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSlice(slice SliceNode) error {
+	if err := validateFields(slice); err != nil {
+		return err
+	}
+	switch slice := slice.(type) {
+	case *Slice:
+		return validateAll(
+			func() error { return validateOptional(slice.Lower) },
+			func() error { return validateOptional(slice.Upper) },
+			func() error { return validateOptional(slice.Step) },
+		)
+	case *ExtSlice:
+		if err := validateNonEmpty(slice, len(slice.Dimensions), "dims", "ExtSlice"); err != nil {
+			return err
+		}
+		for _, dim := range slice.Dimensions {
+			if dim == nil {
+				return invalid(slice, "None disallowed in dims of ExtSlice")
+			}
+			if err := validateSlice(dim); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *Index:
+		return validateExpression(slice.Value, NewLoad())
+	}
+	return invalid(slice, "unknown slice node %T", slice)
+}
+
+// validateExpressions checks a list of expressions, null allows the missing Dict keys and keyword only defaults
+func validateExpressions(exprs []Expression, ctx ExpressionContext, null bool) error {
+	for _, expr := range exprs {
+		if expr == nil {
+			if null {
+				continue
+			}
+			return invalid(nil, "None disallowed in expression list")
+		}
+		if err := validateExpression(expr, ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateOptional checks an optional expression, which is always loaded
+func validateOptional(expr Expression) error {
+	if expr == nil {
+		return nil
+	}
+	return validateExpression(expr, NewLoad())
+}
+
+func contextName(ctx ExpressionContext) string {
+	return strings.TrimSuffix(ctx.String(), "()")
+}
+
+func validateExpression(expr Expression, ctx ExpressionContext) error {
+	if err := validateFields(expr); err != nil {
+		return err
+	}
+
+	// Only the expressions which can be assigned to have a context, it must be the expected one
+	var actual ExpressionContext
+	switch expr := expr.(type) {
+	case *Attribute:
+		actual = expr.Context
+	case *Subscript:
+		actual = expr.Context
+	case *Starred:
+		actual = expr.Context
+	case *Name:
+		actual = expr.Context
+	case *List:
+		actual = expr.Context
+	case *Tuple:
+		actual = expr.Context
+	default:
+		if _, isLoad := ctx.(*Load); !isLoad {
+			return invalid(expr, "expression which can't be assigned to in %s context", contextName(ctx))
+		}
+	}
+	if actual != nil && contextName(actual) != contextName(ctx) {
+		return invalid(expr, "expression must have %s context but has %s instead", contextName(ctx), contextName(actual))
+	}
+
+	switch expr := expr.(type) {
+	case *BoolOp:
+		if len(expr.Values) < 2 {
+			return invalid(expr, "BoolOp with less than 2 values")
+		}
+		return validateExpressions(expr.Values, NewLoad(), false)
+	case *BinOp:
+		return validateAll(
+			func() error { return validateExpression(expr.Left, NewLoad()) },
+			func() error { return validateExpression(expr.Right, NewLoad()) },
+		)
+	case *UnaryOp:
+		return validateExpression(expr.Operand, NewLoad())
+	case *Lambda:
+		return validateAll(
+			func() error { return validateArguments(expr.Args) },
+			func() error { return validateExpression(expr.Body, NewLoad()) },
+		)
+	case *IfExp:
+		return validateAll(
+			func() error { return validateExpression(expr.Test, NewLoad()) },
+			func() error { return validateExpression(expr.Body, NewLoad()) },
+			func() error { return validateExpression(expr.OrElse, NewLoad()) },
+		)
+	case *Dict:
+		if len(expr.Keys) != len(expr.Values) {
+			return invalid(expr, "Dict doesn't have the same number of keys as values")
+		}
+		// A missing key is a ** unpacking
+		return validateAll(
+			func() error { return validateExpressions(expr.Keys, NewLoad(), true) },
+			func() error { return validateExpressions(expr.Values, NewLoad(), false) },
+		)
+	case *Set:
+		return validateExpressions(expr.Elements, NewLoad(), false)
+	case *ListComp:
+		return validateAll(
+			func() error { return validateComprehensions(expr, expr.Generators) },
+			func() error { return validateExpression(expr.Element, NewLoad()) },
+		)
+	case *SetComp:
+		return validateAll(
+			func() error { return validateComprehensions(expr, expr.Generators) },
+			func() error { return validateExpression(expr.Element, NewLoad()) },
+		)
+	case *GeneratorExp:
+		return validateAll(
+			func() error { return validateComprehensions(expr, expr.Generators) },
+			func() error { return validateExpression(expr.Element, NewLoad()) },
+		)
+	case *DictComp:
+		return validateAll(
+			func() error { return validateComprehensions(expr, expr.Generators) },
+			func() error { return validateExpression(expr.Key, NewLoad()) },
+			func() error { return validateExpression(expr.Value, NewLoad()) },
+		)
+	case *Yield:
+		return validateOptional(expr.Value)
+	case *YieldFrom:
+		return validateExpression(expr.Value, NewLoad())
+	case *Await:
+		return validateExpression(expr.Value, NewLoad())
+	case *Compare:
+		if len(expr.Comparators) == 0 {
+			return invalid(expr, "Compare with no comparators")
+		}
+		if len(expr.Comparators) != len(expr.Operators) {
+			return invalid(expr, "Compare has a different number of comparators and operands")
+		}
+		for _, op := range expr.Operators {
+			if op == nil {
+				return invalid(expr, "None disallowed in ops of Compare")
+			}
+		}
+		return validateAll(
+			func() error { return validateExpressions(expr.Comparators, NewLoad(), false) },
+			func() error { return validateExpression(expr.Left, NewLoad()) },
+		)
+	case *Call:
+		return validateAll(
+			func() error { return validateExpression(expr.Function, NewLoad()) },
+			func() error { return validateExpressions(expr.Args, NewLoad(), false) },
+			func() error { return validateKeywords(expr.Keywords) },
+		)
+	case *Num:
+		switch expr.Value.(type) {
+		case *gython.Long, *gython.Float, *gython.Complex:
+			return nil
+		}
+		return invalid(expr, "non-numeric type in Num")
+	case *NameConstant:
+		if expr.Value != gython.None && expr.Value != gython.True && expr.Value != gython.False {
+			return invalid(expr, "singleton must be True, False, or None")
+		}
+		return nil
+	case *Attribute:
+		return validateAll(
+			func() error { return validateIdentifier(expr, expr.Attr) },
+			func() error { return validateExpression(expr.Value, NewLoad()) },
+		)
+	case *Subscript:
+		return validateAll(
+			func() error { return validateSlice(expr.Slice) },
+			func() error { return validateExpression(expr.Value, NewLoad()) },
+		)
+	case *Starred:
+		return validateExpression(expr.Value, ctx)
+	case *Name:
+		if err := validateIdentifier(expr, expr.Identifier); err != nil {
+			return err
+		}
+		switch name := expr.Identifier.String(); name {
+		case "None", "True", "False":
+			return invalid(expr, "Name node can't be used with '%s' constant", name)
+		}
+		return nil
+	case *List:
+		return validateExpressions(expr.Elements, ctx, false)
+	case *Tuple:
+		return validateExpressions(expr.Elements, ctx, false)
+	case *Str, *Bytes, *Ellipsis:
+		return nil
+	}
+	return invalid(expr, "unexpected expression %T", expr)
+}
+
+func validateIdentifier(node Node, identifier *gython.Unicode) error {
+	if !isIdentifier(identifier.Value) {
+		return invalid(node, "invalid identifier %s in %s", identifier.Repr(), typeName(node))
+	}
+	return nil
+}
+
+func validateOptionalIdentifier(node Node, identifier *gython.Unicode) error {
+	if identifier == nil {
+		return nil
+	}
+	return validateIdentifier(node, identifier)
+}
+
+// isIdentifier reports whether name is a valid identifier, like str.isidentifier
+func isIdentifier(name []byte) bool {
+	if len(name) == 0 || !utf8.Valid(name) {
+		return false
+	}
+	for i, r := range string(name) {
+		start := r == '_' || unicode.In(r, unicode.Letter, unicode.Nl, unicode.Other_ID_Start)
+		if i == 0 && !start {
+			return false
+		}
+		if !start && !unicode.In(r, unicode.Mn, unicode.Mc, unicode.Nd, unicode.Pc, unicode.Other_ID_Continue) {
+			return false
+		}
+	}
+	return true
+}
+
+// isDottedName reports whether name is identifiers joined by dots, like an imported module
+func isDottedName(name []byte) bool {
+	for _, part := range strings.Split(string(name), ".") {
+		if !isIdentifier([]byte(part)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package ast
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/brettlangdon/gython/gython"
+)
+
+func TestValidateParsed(t *testing.T) {
+	for _, source := range unparseTests {
+		if err := Validate(parseSource(t, source)); err != nil {
+			t.Errorf("Validate(%q) = %s", source, err)
+		}
+	}
+}
+
+func TestValidateErrors(t *testing.T) {
+	one := func() Expression { return NewNum(gython.NewLong(big.NewInt(1))) }
+	module := func(stmts ...Statement) Mod {
+		mod := NewModule()
+		mod.Body = stmts
+		return mod
+	}
+	assign := func(target Expression, value Expression) Statement {
+		stmt := NewAssign(value)
+		stmt.Append(target)
+		return stmt
+	}
+	defaultsOnly := NewArguments()
+	defaultsOnly.Defaults = []Expression{one()}
+
+	tests := []struct {
+		mod Mod
+		err string
+	}{
+		{module(nil), "None disallowed in statement list"},
+		{module(NewIf(one(), nil, nil)), "empty body on If"},
+		{module(NewAssign(one())), "empty targets on Assign"},
+		{module(NewDelete(nil)), "empty targets on Delete"},
+		{module(NewExpr(nil)), `required field "value" missing from Expr`},
+		{module(NewExpr(NewName("x", nil))), `required field "ctx" missing from Name`},
+		{module(assign(one(), one())), "expression which can't be assigned to in Store context"},
+		{module(assign(NewName("x", NewLoad()), one())), "expression must have Store context but has Load instead"},
+		{module(NewExpr(NewName("x", NewStore()))), "expression must have Load context but has Store instead"},
+		{module(NewDelete([]Expression{NewName("x", NewStore())})), "expression must have Del context but has Store instead"},
+		{module(NewExpr(NewName("1x", NewLoad()))), "invalid identifier '1x' in Name"},
+		{module(NewExpr(NewName("None", NewLoad()))), "Name node can't be used with 'None' constant"},
+		{module(NewExpr(NewBoolOp(NewAnd(), []Expression{one()}))), "BoolOp with less than 2 values"},
+		{module(NewExpr(NewCompare(one(), nil, nil))), "Compare with no comparators"},
+		{module(NewExpr(NewCompare(one(), []CompareOperator{NewEq(), NewLt()}, []Expression{one()}))), "Compare has a different number of comparators and operands"},
+		{module(NewExpr(NewCall(NewName("f", NewLoad()), []Expression{nil}, nil))), "None disallowed in expression list"},
+		{module(NewExpr(NewCall(NewName("f", NewLoad()), nil, []*Keyword{NewKeyword(gython.NewUnicode([]byte("a b")), one())}))), "invalid identifier 'a b' in keyword"},
+		{module(NewExpr(NewNum(gython.None))), "non-numeric type in Num"},
+		{module(NewExpr(NewDict([]Expression{one()}, nil))), "Dict doesn't have the same number of keys as values"},
+		{module(NewExpr(NewListComp(one(), nil))), "comprehension with no generators"},
+		{module(NewRaise(nil, one())), "Raise with cause but no exception"},
+		{module(NewTry([]Statement{NewPass()}, nil, nil, nil)), "Try has neither except handlers nor finalbody"},
+		{module(NewTry([]Statement{NewPass()}, nil, []Statement{NewPass()}, []Statement{NewPass()})), "Try has orelse but no except handlers"},
+		{module(NewImportFrom(nil, nil, 1)), "empty names on ImportFrom"},
+		{module(NewImportFrom(nil, []*Alias{NewAlias("a.b", nil)}, 1)), "invalid name 'a.b' in ImportFrom"},
+		{module(NewImportFrom(nil, []*Alias{NewAlias("a", nil)}, -1)), "Negative ImportFrom level"},
+		{module(NewExpr(NewLambda(defaultsOnly, one()))), "more positional defaults than args on arguments"},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.mod)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("Validate(%s) returned %v, want %q", Dump(test.mod, false), err, test.err)
+		}
+	}
+}
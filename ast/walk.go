@@ -0,0 +1,381 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/brettlangdon/gython/gython"
+)
+
+// Visitor's Visit method is called by Walk for every node of a tree. When the Visitor w it
+// returns is not nil, Walk visits each child of node with w, then calls w.Visit(nil).
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Walk traverses the tree rooted at node depth first, in the order of each node's fields,
+// like ast.NodeVisitor.generic_visit
+func Walk(visitor Visitor, node Node) {
+	if visitor = visitor.Visit(node); visitor == nil {
+		return
+	}
+	for _, child := range children(node) {
+		Walk(visitor, child)
+	}
+	visitor.Visit(nil)
+}
+
+type inspector func(Node) bool
+
+func (inspect inspector) Visit(node Node) Visitor {
+	if inspect(node) {
+		return inspect
+	}
+	return nil
+}
+
+// Inspect calls f for every node of the tree rooted at node, depth first. The children of a
+// node are skipped when f returns false for it, and f(nil) follows the children otherwise.
+func Inspect(node Node, f func(Node) bool) {
+	Walk(inspector(f), node)
+}
+
+// Field is a named field of a node and its value
+type Field struct {
+	Name  string
+	Value interface{}
+}
+
+// withFields is a node which holds other nodes or values
+type withFields interface {
+	Node
+	fieldPointers() []interface{}
+}
+
+// IterFields returns the fields of node with their values, in the order of node.Fields(), like ast.iter_fields
+func IterFields(node Node) []Field {
+	fielded, ok := node.(withFields)
+	if !ok {
+		return nil
+	}
+	names := fielded.Fields()
+	fields := make([]Field, 0, len(names))
+	for i, pointer := range fielded.fieldPointers() {
+		var value interface{}
+		switch pointer := pointer.(type) {
+		case *Expression:
+			value = *pointer
+		case *[]Expression:
+			value = *pointer
+		case *[]Statement:
+			value = *pointer
+		case *ExpressionContext:
+			value = *pointer
+		case *Operator:
+			value = *pointer
+		case *UnaryOperator:
+			value = *pointer
+		case *BooleanOperator:
+			value = *pointer
+		case *[]CompareOperator:
+			value = *pointer
+		case *SliceNode:
+			value = *pointer
+		case *[]SliceNode:
+			value = *pointer
+		case **Arguments:
+			value = *pointer
+		case **Arg:
+			value = *pointer
+		case *[]*Arg:
+			value = *pointer
+		case *[]*Keyword:
+			value = *pointer
+		case *[]*Alias:
+			value = *pointer
+		case *[]*WithItem:
+			value = *pointer
+		case *[]*Comprehension:
+			value = *pointer
+		case *[]*ExceptHandler:
+			value = *pointer
+		case **gython.Unicode:
+			value = *pointer
+		case *[]*gython.Unicode:
+			value = *pointer
+		case **gython.Bytes:
+			value = *pointer
+		case *gython.Object:
+			value = *pointer
+		case *int:
+			value = *pointer
+		}
+		fields = append(fields, Field{Name: names[i], Value: value})
+	}
+	return fields
+}
+
+// children returns the nodes held by the fields of node, leaving out missing optional ones
+func children(node Node) []Node {
+	nodes := make([]Node, 0)
+	add := func(child Node) {
+		nodes = append(nodes, child)
+	}
+	for _, field := range IterFields(node) {
+		switch value := field.Value.(type) {
+		case Node:
+			// A typed nil pointer, like a missing vararg, is not a child
+			if !isNilNode(value) {
+				add(value)
+			}
+		case []Expression:
+			for _, expr := range value {
+				if expr != nil {
+					add(expr)
+				}
+			}
+		case []Statement:
+			for _, stmt := range value {
+				add(stmt)
+			}
+		case []CompareOperator:
+			for _, op := range value {
+				add(op)
+			}
+		case []SliceNode:
+			for _, slice := range value {
+				add(slice)
+			}
+		case []*Arg:
+			for _, arg := range value {
+				add(arg)
+			}
+		case []*Keyword:
+			for _, keyword := range value {
+				add(keyword)
+			}
+		case []*Alias:
+			for _, alias := range value {
+				add(alias)
+			}
+		case []*WithItem:
+			for _, item := range value {
+				add(item)
+			}
+		case []*Comprehension:
+			for _, comprehension := range value {
+				add(comprehension)
+			}
+		case []*ExceptHandler:
+			for _, handler := range value {
+				add(handler)
+			}
+		}
+	}
+	return nodes
+}
+
+// isNilNode reports whether node is a nil pointer, which only the optional *Arguments and *Arg fields hold
+func isNilNode(node Node) bool {
+	switch node := node.(type) {
+	case *Arguments:
+		return node == nil
+	case *Arg:
+		return node == nil
+	}
+	return false
+}
+
+// Transformer rewrites a tree like ast.NodeTransformer. Transform returns the node to put in
+// place of node, which may be node itself, or nil to remove it from a list or clear an optional
+// field. To transform the children of node as well it calls TransformFields, like generic_visit,
+// and returns the error it gets back.
+type Transformer interface {
+	Transform(node Node) (Node, error)
+}
+
+// TransformFields replaces every node held by the fields of node with the result of
+// transformer.Transform. It stops at the first error from Transform, or at a replacement
+// which is not the kind of node its field holds, and returns it. The nodes after that one
+// are left as they were.
+func TransformFields(transformer Transformer, node Node) error {
+	fielded, ok := node.(withFields)
+	if !ok {
+		return nil
+	}
+	names := fielded.Fields()
+	t := &fieldTransformer{transformer: transformer, node: node}
+	for i, pointer := range fielded.fieldPointers() {
+		t.field = names[i]
+		switch pointer := pointer.(type) {
+		case *Expression:
+			if *pointer != nil {
+				*pointer = t.expression(*pointer)
+			}
+		case *[]Expression:
+			*pointer = t.expressions(*pointer)
+		case *[]Statement:
+			stmts := make([]Statement, 0, len(*pointer))
+			for _, stmt := range *pointer {
+				if stmt, ok := t.transform(stmt).(Statement); ok {
+					stmts = append(stmts, stmt)
+				}
+			}
+			*pointer = stmts
+		case *ExpressionContext:
+			*pointer, _ = t.transform(*pointer).(ExpressionContext)
+		case *Operator:
+			*pointer, _ = t.transform(*pointer).(Operator)
+		case *UnaryOperator:
+			*pointer, _ = t.transform(*pointer).(UnaryOperator)
+		case *BooleanOperator:
+			*pointer, _ = t.transform(*pointer).(BooleanOperator)
+		case *[]CompareOperator:
+			ops := make([]CompareOperator, 0, len(*pointer))
+			for _, op := range *pointer {
+				if op, ok := t.transform(op).(CompareOperator); ok {
+					ops = append(ops, op)
+				}
+			}
+			*pointer = ops
+		case *SliceNode:
+			*pointer, _ = t.transform(*pointer).(SliceNode)
+		case *[]SliceNode:
+			slices := make([]SliceNode, 0, len(*pointer))
+			for _, slice := range *pointer {
+				if slice, ok := t.transform(slice).(SliceNode); ok {
+					slices = append(slices, slice)
+				}
+			}
+			*pointer = slices
+		case **Arguments:
+			if *pointer != nil {
+				*pointer, _ = t.transform(*pointer).(*Arguments)
+			}
+		case **Arg:
+			if *pointer != nil {
+				*pointer, _ = t.transform(*pointer).(*Arg)
+			}
+		case *[]*Arg:
+			args := make([]*Arg, 0, len(*pointer))
+			for _, arg := range *pointer {
+				if arg, ok := t.transform(arg).(*Arg); ok {
+					args = append(args, arg)
+				}
+			}
+			*pointer = args
+		case *[]*Keyword:
+			keywords := make([]*Keyword, 0, len(*pointer))
+			for _, keyword := range *pointer {
+				if keyword, ok := t.transform(keyword).(*Keyword); ok {
+					keywords = append(keywords, keyword)
+				}
+			}
+			*pointer = keywords
+		case *[]*Alias:
+			aliases := make([]*Alias, 0, len(*pointer))
+			for _, alias := range *pointer {
+				if alias, ok := t.transform(alias).(*Alias); ok {
+					aliases = append(aliases, alias)
+				}
+			}
+			*pointer = aliases
+		case *[]*WithItem:
+			items := make([]*WithItem, 0, len(*pointer))
+			for _, item := range *pointer {
+				if item, ok := t.transform(item).(*WithItem); ok {
+					items = append(items, item)
+				}
+			}
+			*pointer = items
+		case *[]*Comprehension:
+			comprehensions := make([]*Comprehension, 0, len(*pointer))
+			for _, comprehension := range *pointer {
+				if comprehension, ok := t.transform(comprehension).(*Comprehension); ok {
+					comprehensions = append(comprehensions, comprehension)
+				}
+			}
+			*pointer = comprehensions
+		case *[]*ExceptHandler:
+			handlers := make([]*ExceptHandler, 0, len(*pointer))
+			for _, handler := range *pointer {
+				if handler, ok := t.transform(handler).(*ExceptHandler); ok {
+					handlers = append(handlers, handler)
+				}
+			}
+			*pointer = handlers
+		}
+		if t.err != nil {
+			return t.err
+		}
+	}
+	return nil
+}
+
+// fieldTransformer transforms the nodes of one field, checking the replacements fit the field
+type fieldTransformer struct {
+	transformer Transformer
+	node        Node
+	field       string
+	err         error
+}
+
+// transform returns the replacement of child, nil when it is removed. Once there is an
+// error every child is returned as it is.
+func (t *fieldTransformer) transform(child Node) Node {
+	if t.err != nil {
+		return child
+	}
+	result, err := t.transformer.Transform(child)
+	if err != nil {
+		t.err = err
+		return child
+	}
+	if result == nil {
+		return nil
+	}
+
+	fits := true
+	switch child.(type) {
+	case Expression:
+		_, fits = result.(Expression)
+	case Statement:
+		_, fits = result.(Statement)
+	case ExpressionContext:
+		_, fits = result.(ExpressionContext)
+	case Operator:
+		_, fits = result.(Operator)
+	case UnaryOperator:
+		_, fits = result.(UnaryOperator)
+	case BooleanOperator:
+		_, fits = result.(BooleanOperator)
+	case CompareOperator:
+		_, fits = result.(CompareOperator)
+	case SliceNode:
+		_, fits = result.(SliceNode)
+	default:
+		fits = fmt.Sprintf("%T", child) == fmt.Sprintf("%T", result)
+	}
+	if !fits {
+		t.err = fmt.Errorf("ast: cannot put %T in the %s field of %T", result, t.field, t.node)
+		return child
+	}
+	return result
+}
+
+func (t *fieldTransformer) expression(expr Expression) Expression {
+	result, _ := t.transform(expr).(Expression)
+	return result
+}
+
+// expressions transforms a list of expressions, a missing Dict key or keyword only default stays in its place
+func (t *fieldTransformer) expressions(exprs []Expression) []Expression {
+	results := make([]Expression, 0, len(exprs))
+	for _, expr := range exprs {
+		if expr == nil {
+			results = append(results, nil)
+		} else if result := t.expression(expr); result != nil {
+			results = append(results, result)
+		}
+	}
+	return results
+}
@@ -0,0 +1,157 @@
+package ast
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/brettlangdon/gython/gython"
+)
+
+// walkOrder returns the nodes Walk visits as "Type(" and the Visit(nil) after their children as ")"
+func walkOrder(node Node, skip string) string {
+	var order strings.Builder
+	Inspect(node, func(node Node) bool {
+		if node == nil {
+			order.WriteString(")")
+			return false
+		}
+		order.WriteString(typeName(node) + "(")
+		if typeName(node) == skip {
+			order.WriteString(")")
+			return false
+		}
+		return true
+	})
+	return order.String()
+}
+
+func TestWalkOrder(t *testing.T) {
+	mod := parseSource(t, "@d\ndef f(a=1):\n    return a + b\n")
+	tests := []struct {
+		skip  string
+		order string
+	}{
+		// Children are visited depth first in the order of Fields, like generic_visit
+		{"", "Module(FunctionDef(arguments(arg()Num())Return(BinOp(Name(Load())Add()Name(Load())))Name(Load())))"},
+		// The children of a node are skipped when the Visitor returns nil for it, and so is its Visit(nil)
+		{"BinOp", "Module(FunctionDef(arguments(arg()Num())Return(BinOp())Name(Load())))"},
+		{"Module", "Module()"},
+	}
+	for _, test := range tests {
+		if order := walkOrder(mod, test.skip); order != test.order {
+			t.Errorf("skipping %q Walk visited %s, want %s", test.skip, order, test.order)
+		}
+	}
+}
+
+func TestFieldsAndAttributes(t *testing.T) {
+	position := []string{"lineno", "col_offset"}
+	tests := []struct {
+		node       Node
+		fields     []string
+		attributes []string
+	}{
+		{NewModule(), []string{"body"}, nil},
+		{NewPass(), nil, position},
+		{NewFunctionDef("f", NewArguments(), nil, nil, nil), []string{"name", "args", "body", "decorator_list", "returns"}, position},
+		{NewBinOp(nil, NewAdd(), nil), []string{"left", "op", "right"}, position},
+		{NewName("x", NewLoad()), []string{"id", "ctx"}, position},
+		{NewArguments(), []string{"args", "vararg", "kwonlyargs", "kw_defaults", "kwarg", "defaults"}, nil},
+		{NewArg("a", nil), []string{"arg", "annotation"}, position},
+		{NewLoad(), nil, nil},
+		{NewAdd(), nil, nil},
+	}
+	for _, test := range tests {
+		if fields := test.node.Fields(); !reflect.DeepEqual(fields, test.fields) {
+			t.Errorf("%s.Fields() = %q, want %q", typeName(test.node), fields, test.fields)
+		}
+		if attributes := test.node.Attributes(); !reflect.DeepEqual(attributes, test.attributes) {
+			t.Errorf("%s.Attributes() = %q, want %q", typeName(test.node), attributes, test.attributes)
+		}
+	}
+
+	left, right := NewName("a", NewLoad()), NewName("b", NewLoad())
+	binOp := NewBinOp(left, NewAdd(), right)
+	fields := IterFields(binOp)
+	if len(fields) != 3 || fields[0].Name != "left" || fields[0].Value != left || fields[2].Name != "right" || fields[2].Value != right {
+		t.Errorf("IterFields(BinOp) = %#v", fields)
+	}
+}
+
+// transformFunc is a Transformer which transforms the children of every node f returns
+type transformFunc func(node Node) (Node, error)
+
+func (f transformFunc) Transform(node Node) (Node, error) {
+	result, err := f(node)
+	if err != nil || result == nil {
+		return result, err
+	}
+	return result, TransformFields(f, result)
+}
+
+func TestTransformFields(t *testing.T) {
+	mod := parseSource(t, "def f():\n    pass\n    x = y\n    pass\nif y:\n    pass\n    y = 2\n")
+	err := TransformFields(transformFunc(func(node Node) (Node, error) {
+		switch node := node.(type) {
+		case *Pass:
+			// Removed from the Body it is in
+			return nil, nil
+		case *Name:
+			if _, isLoad := node.Context.(*Load); isLoad && node.Identifier.String() == "y" {
+				num := NewNum(gython.NewLong(big.NewInt(1)))
+				num.Position = node.Position
+				return num, nil
+			}
+		}
+		return node, nil
+	}), mod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unparsed, want := Unparse(mod), "def f():\n    x = 1\nif 1:\n    y = 2\n"; unparsed != want {
+		t.Errorf("the transformed module is %q, want %q", unparsed, want)
+	}
+}
+
+func TestTransformFieldsErrors(t *testing.T) {
+	source := "x = a\ny = b\n"
+	errStop := errors.New("stop")
+	tests := []struct {
+		transform func(node Node) (Node, error)
+		err       string
+	}{
+		// A statement can't replace an expression
+		{
+			func(node Node) (Node, error) {
+				if name, isName := node.(*Name); isName && name.Identifier.String() == "a" {
+					return NewPass(), nil
+				}
+				return node, nil
+			},
+			"ast: cannot put *ast.Pass in the value field of *ast.Assign",
+		},
+		{
+			func(node Node) (Node, error) {
+				if _, isName := node.(*Name); isName {
+					return nil, errStop
+				}
+				return node, nil
+			},
+			"stop",
+		},
+	}
+	for _, test := range tests {
+		mod := parseSource(t, source)
+		err := TransformFields(transformFunc(test.transform), mod)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("TransformFields returned %v, want the error %q", err, test.err)
+		}
+		// The nodes after the error are left as they were
+		if unparsed := Unparse(mod); unparsed != source {
+			t.Errorf("after the error the module is %q, want %q", unparsed, source)
+		}
+	}
+}
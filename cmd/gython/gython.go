@@ -1,8 +1,13 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"unicode/utf8"
 
 	"github.com/brettlangdon/gython/ast"
 	"github.com/brettlangdon/gython/compiler"
@@ -11,14 +16,108 @@ import (
 	"github.com/brettlangdon/gython/token"
 )
 
-func tokenize() {
-	tokenizer := scanner.NewScanner(os.Stdin)
+// jsonToken is a token as printed by tokenize -json, in the terms of Python's tokenize module
+type jsonToken struct {
+	Type      string `json:"type"`
+	ExactType string `json:"exact_type"`
+	Literal   string `json:"literal"`
+	Start     []int  `json:"start"`
+	End       []int  `json:"end"`
+	Line      string `json:"line"`
+}
+
+func newJSONToken(tok *token.Token, source []byte) *jsonToken {
+	tokenType := tok.String()
+	if tok.ID.IsOperator() {
+		tokenType = "OP"
+	} else if tok.ID == token.ASYNC || tok.ID == token.AWAIT {
+		// The tokenize module has no ASYNC or AWAIT tokens
+		tokenType = "NAME"
+	}
+
+	// The physical lines the token is on, like the line attribute of a TokenInfo
+	line := ""
+	if tok.OffsetStart < len(source) {
+		start := bytes.LastIndexByte(source[:tok.OffsetStart], '\n') + 1
+		end := tok.OffsetStart
+		if tok.OffsetEnd > end {
+			end = tok.OffsetEnd - 1
+		}
+		if next := bytes.IndexByte(source[end:], '\n'); next >= 0 {
+			end += next + 1
+		} else {
+			end = len(source)
+		}
+		line = string(source[start:end])
+	}
+
+	// Token columns count bytes, the tokenize module counts characters
+	startLine := tok.OffsetStart - tok.ColumnStart
+	endLine := startLine
+	if tok.LineEnd != tok.LineStart {
+		endLine = bytes.LastIndexAny(source[:tok.OffsetEnd], "\r\n") + 1
+	}
+
+	return &jsonToken{
+		Type:      tokenType,
+		ExactType: tok.String(),
+		Literal:   tok.Literal,
+		Start:     []int{tok.LineStart, charColumn(source, startLine, tok.ColumnStart)},
+		End:       []int{tok.LineEnd, charColumn(source, endLine, tok.ColumnEnd)},
+		Line:      line,
+	}
+}
+
+// charColumn converts a byte column on the line starting at the offset lineStart into a column in characters
+func charColumn(source []byte, lineStart int, column int) int {
+	end := lineStart + column
+	if end <= len(source) {
+		return utf8.RuneCount(source[lineStart:end])
+	}
+	// The NEWLINE added after a last line without one ends past the source
+	return utf8.RuneCount(source[lineStart:]) + end - len(source)
+}
+
+// typedError is an error which names the Python exception it stands for
+type typedError interface {
+	error
+	Type() string
+}
+
+// printError prints err like the last line of a Python traceback, errors without a type are SyntaxErrors
+func printError(err error) {
+	errType := "SyntaxError"
+	if typed, isTyped := err.(typedError); isTyped {
+		errType = typed.Type()
+	}
+	fmt.Fprintf(os.Stderr, "%s: %s\n", errType, err)
+}
+
+func tokenize(jsonOutput bool) {
+	source, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "OSError: %s\n", err)
+		os.Exit(1)
+	}
+	tokenizer := scanner.NewBytesScanner(source)
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetEscapeHTML(false)
 	for {
 		tok := tokenizer.NextToken()
-		tokenRange := fmt.Sprintf("%d,%d-%d,%d:", tok.LineStart, tok.ColumnStart, tok.LineEnd, tok.ColumnEnd)
-		literalRep := fmt.Sprintf("%#v", tok.Literal)
-		fmt.Printf("%-20s%-15s%-15s\n", tokenRange, tok.String(), literalRep)
-		if tok.ID == token.ENDMARKER || tok.ID == token.ERRORTOKEN {
+		if jsonOutput {
+			encoder.Encode(newJSONToken(tok, source))
+		} else {
+			tokenRange := fmt.Sprintf("%d,%d-%d,%d:", tok.LineStart, tok.ColumnStart, tok.LineEnd, tok.ColumnEnd)
+			literalRep := fmt.Sprintf("%#v", tok.Literal)
+			fmt.Printf("%-20s%-15s%-15s\n", tokenRange, tok.String(), literalRep)
+		}
+		if tok.ID == token.ERRORTOKEN {
+			if err := tokenizer.Err(); err != nil {
+				printError(err)
+			}
+			break
+		}
+		if tok.ID == token.ENDMARKER {
 			break
 		}
 	}
@@ -27,28 +126,49 @@ func tokenize() {
 func parseGrammar() *grammar.FileInput {
 	tokenizer := scanner.NewScanner(os.Stdin)
 	gp := grammar.NewGrammarParser(tokenizer)
-	return gp.Parse()
+	start := gp.Parse()
+	if len(gp.Errors) > 0 {
+		for _, err := range gp.Errors {
+			printError(err)
+		}
+		os.Exit(1)
+	}
+	return start
 }
 
 func parseAST() ast.Mod {
 	start := parseGrammar()
 	mod, err := ast.ASTFromGrammar(start)
 	if err != nil {
-		panic(err)
+		printError(err)
+		os.Exit(1)
 	}
 	return mod
 }
 
 func compile() {
 	root := parseAST()
-	codeobject := compiler.CompileAST(root)
+	codeobject, err := compiler.CompileAST(root)
+	if err != nil {
+		printError(err)
+		os.Exit(1)
+	}
 	fmt.Println(codeobject)
 }
 
 func main() {
-	// start := parseGrammar()
-	// fmt.Println(start.Repr())
-	// root := parseAST()
-	// fmt.Println(root)
-	compile()
+	jsonOutput := flag.Bool("json", false, "print tokens as JSON lines")
+	attributes := flag.Bool("attributes", false, "include lineno and col_offset when printing the ast")
+	flag.Parse()
+
+	switch flag.Arg(0) {
+	case "tokenize":
+		tokenize(*jsonOutput)
+	case "grammar":
+		fmt.Println(parseGrammar().Repr())
+	case "ast":
+		fmt.Println(ast.Dump(parseAST(), *attributes))
+	default:
+		compile()
+	}
 }
@@ -12,6 +12,8 @@ type Compiler struct {
 	nestLevel    int
 	currentScope *Scope
 	scopeStack   []*Scope
+	// lineno is the line of the statement being compiled, given to each instruction
+	lineno int
 }
 
 func NewCompiler() *Compiler {
@@ -76,6 +78,7 @@ func (compiler *Compiler) assemble(addNone bool) *gython.CodeObject {
 
 func (compiler *Compiler) addOp(op bytecode.Opcode) {
 	instr := NewInstruction(op, nil, false)
+	instr.Line = compiler.lineno
 	compiler.currentScope.AddInstruction(instr)
 }
 
@@ -83,6 +86,7 @@ func (compiler *Compiler) addOpWithObject(op bytecode.Opcode, value gython.Objec
 	oparg := args.Length()
 	args.SetItem(oparg, value)
 	instr := NewInstruction(op, oparg, true)
+	instr.Line = compiler.lineno
 	compiler.currentScope.AddInstruction(instr)
 }
 
@@ -90,6 +94,10 @@ func (compiler *Compiler) visitExpression(expr ast.Expression) bool {
 	switch expr := expr.(type) {
 	case *ast.Num:
 		compiler.addOpWithObject(bytecode.LOAD_CONST, expr.Value, compiler.currentScope.Constants)
+	case *ast.NameConstant:
+		compiler.addOpWithObject(bytecode.LOAD_CONST, expr.Value, compiler.currentScope.Constants)
+	case *ast.Ellipsis:
+		compiler.addOpWithObject(bytecode.LOAD_CONST, gython.Ellipsis, compiler.currentScope.Constants)
 	case *ast.Name:
 		compiler.addOpWithObject(bytecode.STORE_NAME, expr.Identifier, compiler.currentScope.Constants)
 	default:
@@ -100,6 +108,7 @@ func (compiler *Compiler) visitExpression(expr ast.Expression) bool {
 }
 
 func (compiler *Compiler) visitStatement(stmt ast.Statement) bool {
+	compiler.lineno = stmt.Pos().Lineno
 	switch stmt := stmt.(type) {
 	case *ast.Assign:
 		compiler.visitExpression(stmt.Value)
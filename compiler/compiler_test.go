@@ -0,0 +1,61 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/brettlangdon/gython/ast"
+	"github.com/brettlangdon/gython/bytecode"
+	"github.com/brettlangdon/gython/grammar"
+	"github.com/brettlangdon/gython/gython"
+	"github.com/brettlangdon/gython/scanner"
+)
+
+// parseSource parses and lowers source, failing the test on an error
+func parseSource(t *testing.T, source string) *ast.Module {
+	gp := grammar.NewGrammarParser(scanner.NewBytesScanner([]byte(source)))
+	start := gp.Parse()
+	if len(gp.Errors) > 0 {
+		t.Fatalf("parsing %q: %s", source, gp.Errors[0])
+	}
+	mod, err := ast.ASTFromGrammar(start)
+	if err != nil {
+		t.Fatalf("parsing %q: %s", source, err)
+	}
+	return mod.(*ast.Module)
+}
+
+func TestCompileASTValidates(t *testing.T) {
+	// An If with an empty body can't come from the parser
+	mod := ast.NewModule()
+	mod.Body = append(mod.Body, ast.NewIf(ast.NewName("a", ast.NewLoad()), nil, nil))
+	codeobject, err := CompileAST(mod)
+	if _, isValidation := err.(*ast.ValidationError); !isValidation || err.Error() != "empty body on If" {
+		t.Errorf("CompileAST of an invalid tree = %v, %v, want a ValidationError", codeobject, err)
+	}
+}
+
+func TestCompileConstants(t *testing.T) {
+	tests := []struct {
+		source   string
+		constant gython.Object
+	}{
+		{"x = None\n", gython.None},
+		{"x = True\n", gython.True},
+		{"x = False\n", gython.False},
+		{"x = ...\n", gython.Ellipsis},
+	}
+	for _, test := range tests {
+		compiler := NewCompiler()
+		compiler.enterScope()
+		compiler.compileBody(parseSource(t, test.source).Body)
+		instructions := compiler.currentScope.Instructions
+		if len(instructions) == 0 || instructions[0].Opcode != bytecode.LOAD_CONST || !instructions[0].Hasarg {
+			t.Errorf("compiling %q didn't start with a LOAD_CONST", test.source)
+			continue
+		}
+		constant, err := compiler.currentScope.Constants.GetItem(instructions[0].Oparg)
+		if err != nil || constant != test.constant {
+			t.Errorf("compiling %q loaded the constant %#v, %v, want %#v", test.source, constant, err, test.constant)
+		}
+	}
+}
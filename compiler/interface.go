@@ -5,7 +5,12 @@ import (
 	"github.com/brettlangdon/gython/gython"
 )
 
-func CompileAST(root ast.Mod) *gython.CodeObject {
+// CompileAST compiles root after checking it with ast.Validate, so a tree built by hand or
+// decoded from JSON is rejected with an error instead of reaching code generation
+func CompileAST(root ast.Mod) (*gython.CodeObject, error) {
+	if err := ast.Validate(root); err != nil {
+		return nil, err
+	}
 	compiler := NewCompiler()
-	return compiler.CompileMod(root)
+	return compiler.CompileMod(root), nil
 }
@@ -1,9 +1,26 @@
 package error
 
+import (
+	"fmt"
+
+	"github.com/brettlangdon/gython/errorcode"
+)
+
 type Error struct {
+	Code    errorcode.ErrorCode
 	Message string
+	Line    int
+	Column  int
 }
 
 func (e Error) Error() string {
-	return e.Message
+	if e.Line == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message)
+}
+
+// Type returns the name of the Python exception CPython raises for this error
+func (e Error) Type() string {
+	return e.Code.ExceptionName()
 }
@@ -26,3 +26,25 @@ const (
 	E_IDENTIFIER ErrorCode = 26 /* Invalid characters in identifier */
 	E_BADSINGLE  ErrorCode = 27 /* Ill-formed single statement input */
 )
+
+func (code ErrorCode) Message() string {
+	return ErrorMessages[code]
+}
+
+// ExceptionName returns the name of the Python exception CPython raises for this code
+func (code ErrorCode) ExceptionName() string {
+	switch code {
+	case E_TABSPACE:
+		return "TabError"
+	case E_DEDENT, E_TOODEEP:
+		return "IndentationError"
+	case E_INTR:
+		return "KeyboardInterrupt"
+	case E_NOMEM:
+		return "MemoryError"
+	case E_ERROR:
+		// The scanner only uses E_ERROR when reading the source fails
+		return "OSError"
+	}
+	return "SyntaxError"
+}
@@ -0,0 +1,23 @@
+package errorcode
+
+// ErrorMessages holds the human readable message CPython reports for each error code
+var ErrorMessages = [...]string{
+	E_OK:         "",
+	E_EOF:        "unexpected EOF while parsing",
+	E_INTR:       "interrupted",
+	E_TOKEN:      "invalid token",
+	E_SYNTAX:     "invalid syntax",
+	E_NOMEM:      "out of memory",
+	E_DONE:       "",
+	E_ERROR:      "execution error",
+	E_TABSPACE:   "inconsistent use of tabs and spaces in indentation",
+	E_OVERFLOW:   "expression too long",
+	E_TOODEEP:    "too many levels of indentation",
+	E_DEDENT:     "unindent does not match any outer indentation level",
+	E_DECODE:     "unknown decode error",
+	E_EOFS:       "EOF while scanning triple-quoted string literal",
+	E_EOLS:       "EOL while scanning string literal",
+	E_LINECONT:   "unexpected character after line continuation character",
+	E_IDENTIFIER: "invalid character in identifier",
+	E_BADSINGLE:  "multiple statements found while compiling a single statement",
+}
@@ -0,0 +1,200 @@
+package grammar
+
+import "github.com/brettlangdon/gython/symbol"
+
+type CompoundStatementChild interface {
+	Node
+	compoundStatementChild()
+}
+
+type CompoundStatement struct {
+	ParentNode
+}
+
+func NewCompoundStatement() *CompoundStatement {
+	node := &CompoundStatement{}
+	node.initBaseNode(symbol.COMPOUND_STMT)
+	return node
+}
+
+func (node *CompoundStatement) stmtChild()                        {}
+func (node *CompoundStatement) SetChild(n CompoundStatementChild) { node.ParentNode.SetChild(n) }
+
+type AsyncStatementChild interface {
+	Node
+	asyncStatementChild()
+}
+
+type AsyncStatement struct {
+	ListNode
+}
+
+func NewAsyncStatement() *AsyncStatement {
+	node := &AsyncStatement{}
+	node.initBaseNode(symbol.ASYNC_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *AsyncStatement) compoundStatementChild()      {}
+func (node *AsyncStatement) Append(n AsyncStatementChild) { node.ListNode.Append(n) }
+
+type IfStatementChild interface {
+	Node
+	ifStatementChild()
+}
+
+type IfStatement struct {
+	ListNode
+}
+
+func NewIfStatement() *IfStatement {
+	node := &IfStatement{}
+	node.initBaseNode(symbol.IF_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *IfStatement) compoundStatementChild()   {}
+func (node *IfStatement) Append(n IfStatementChild) { node.ListNode.Append(n) }
+
+type WhileStatementChild interface {
+	Node
+	whileStatementChild()
+}
+
+type WhileStatement struct {
+	ListNode
+}
+
+func NewWhileStatement() *WhileStatement {
+	node := &WhileStatement{}
+	node.initBaseNode(symbol.WHILE_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *WhileStatement) compoundStatementChild()      {}
+func (node *WhileStatement) Append(n WhileStatementChild) { node.ListNode.Append(n) }
+
+type ForStatementChild interface {
+	Node
+	forStatementChild()
+}
+
+type ForStatement struct {
+	ListNode
+}
+
+func NewForStatement() *ForStatement {
+	node := &ForStatement{}
+	node.initBaseNode(symbol.FOR_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *ForStatement) asyncStatementChild()       {}
+func (node *ForStatement) compoundStatementChild()    {}
+func (node *ForStatement) Append(n ForStatementChild) { node.ListNode.Append(n) }
+
+type TryStatementChild interface {
+	Node
+	tryStatementChild()
+}
+
+type TryStatement struct {
+	ListNode
+}
+
+func NewTryStatement() *TryStatement {
+	node := &TryStatement{}
+	node.initBaseNode(symbol.TRY_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *TryStatement) compoundStatementChild()    {}
+func (node *TryStatement) Append(n TryStatementChild) { node.ListNode.Append(n) }
+
+type WithStatementChild interface {
+	Node
+	withStatementChild()
+}
+
+type WithStatement struct {
+	ListNode
+}
+
+func NewWithStatement() *WithStatement {
+	node := &WithStatement{}
+	node.initBaseNode(symbol.WITH_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *WithStatement) asyncStatementChild()        {}
+func (node *WithStatement) compoundStatementChild()     {}
+func (node *WithStatement) Append(n WithStatementChild) { node.ListNode.Append(n) }
+
+type WithItemChild interface {
+	Node
+	withItemChild()
+}
+
+type WithItem struct {
+	ListNode
+}
+
+func NewWithItem() *WithItem {
+	node := &WithItem{}
+	node.initBaseNode(symbol.WITH_ITEM)
+	node.initListNode()
+	return node
+}
+
+func (node *WithItem) withStatementChild()    {}
+func (node *WithItem) Append(n WithItemChild) { node.ListNode.Append(n) }
+
+type ExceptClauseChild interface {
+	Node
+	exceptClauseChild()
+}
+
+type ExceptClause struct {
+	ListNode
+}
+
+func NewExceptClause() *ExceptClause {
+	node := &ExceptClause{}
+	node.initBaseNode(symbol.EXCEPT_CLAUSE)
+	node.initListNode()
+	return node
+}
+
+func (node *ExceptClause) tryStatementChild()         {}
+func (node *ExceptClause) Append(n ExceptClauseChild) { node.ListNode.Append(n) }
+
+type SuiteChild interface {
+	Node
+	suiteChild()
+}
+
+type Suite struct {
+	ListNode
+}
+
+func NewSuite() *Suite {
+	node := &Suite{}
+	node.initBaseNode(symbol.SUITE)
+	node.initListNode()
+	return node
+}
+
+func (node *Suite) classDefinitionChild()    {}
+func (node *Suite) forStatementChild()       {}
+func (node *Suite) functionDefinitionChild() {}
+func (node *Suite) ifStatementChild()        {}
+func (node *Suite) tryStatementChild()       {}
+func (node *Suite) whileStatementChild()     {}
+func (node *Suite) withStatementChild()      {}
+func (node *Suite) Append(n SuiteChild)      { node.ListNode.Append(n) }
@@ -0,0 +1,65 @@
+package grammar
+
+import "github.com/brettlangdon/gython/symbol"
+
+type ComprehensionIteratorChild interface {
+	Node
+	comprehensionIteratorChild()
+}
+
+type ComprehensionIterator struct {
+	ParentNode
+}
+
+func NewComprehensionIterator() *ComprehensionIterator {
+	node := &ComprehensionIterator{}
+	node.initBaseNode(symbol.COMP_ITER)
+	return node
+}
+
+func (node *ComprehensionIterator) comprehensionForChild() {}
+func (node *ComprehensionIterator) comprehensionIfChild()  {}
+func (node *ComprehensionIterator) SetChild(n ComprehensionIteratorChild) {
+	node.ParentNode.SetChild(n)
+}
+
+type ComprehensionForChild interface {
+	Node
+	comprehensionForChild()
+}
+
+type ComprehensionFor struct {
+	ListNode
+}
+
+func NewComprehensionFor() *ComprehensionFor {
+	node := &ComprehensionFor{}
+	node.initBaseNode(symbol.COMP_FOR)
+	node.initListNode()
+	return node
+}
+
+func (node *ComprehensionFor) argumentChild()                 {}
+func (node *ComprehensionFor) comprehensionIteratorChild()    {}
+func (node *ComprehensionFor) dictOrSetMakerChild()           {}
+func (node *ComprehensionFor) testlistComprehensionChild()    {}
+func (node *ComprehensionFor) Append(n ComprehensionForChild) { node.ListNode.Append(n) }
+
+type ComprehensionIfChild interface {
+	Node
+	comprehensionIfChild()
+}
+
+type ComprehensionIf struct {
+	ListNode
+}
+
+func NewComprehensionIf() *ComprehensionIf {
+	node := &ComprehensionIf{}
+	node.initBaseNode(symbol.COMP_IF)
+	node.initListNode()
+	return node
+}
+
+func (node *ComprehensionIf) comprehensionIteratorChild()   {}
+func (node *ComprehensionIf) Append(n ComprehensionIfChild) { node.ListNode.Append(n) }
@@ -0,0 +1,217 @@
+package grammar
+
+import "github.com/brettlangdon/gython/symbol"
+
+type DecoratorChild interface {
+	Node
+	decoratorChild()
+}
+
+type Decorator struct {
+	ListNode
+}
+
+func NewDecorator() *Decorator {
+	node := &Decorator{}
+	node.initBaseNode(symbol.DECORATOR)
+	node.initListNode()
+	return node
+}
+
+func (node *Decorator) decoratorsChild()        {}
+func (node *Decorator) Append(n DecoratorChild) { node.ListNode.Append(n) }
+
+type DecoratorsChild interface {
+	Node
+	decoratorsChild()
+}
+
+type Decorators struct {
+	ListNode
+}
+
+func NewDecorators() *Decorators {
+	node := &Decorators{}
+	node.initBaseNode(symbol.DECORATORS)
+	node.initListNode()
+	return node
+}
+
+func (node *Decorators) decoratedChild()          {}
+func (node *Decorators) Append(n DecoratorsChild) { node.ListNode.Append(n) }
+
+type DecoratedChild interface {
+	Node
+	decoratedChild()
+}
+
+type Decorated struct {
+	ListNode
+}
+
+func NewDecorated() *Decorated {
+	node := &Decorated{}
+	node.initBaseNode(symbol.DECORATED)
+	node.initListNode()
+	return node
+}
+
+func (node *Decorated) compoundStatementChild() {}
+func (node *Decorated) Append(n DecoratedChild) { node.ListNode.Append(n) }
+
+type AsyncFunctionDefinitionChild interface {
+	Node
+	asyncFunctionDefinitionChild()
+}
+
+type AsyncFunctionDefinition struct {
+	ListNode
+}
+
+func NewAsyncFunctionDefinition() *AsyncFunctionDefinition {
+	node := &AsyncFunctionDefinition{}
+	node.initBaseNode(symbol.ASYNC_FUNCDEF)
+	node.initListNode()
+	return node
+}
+
+func (node *AsyncFunctionDefinition) decoratedChild()                       {}
+func (node *AsyncFunctionDefinition) Append(n AsyncFunctionDefinitionChild) { node.ListNode.Append(n) }
+
+type FunctionDefinitionChild interface {
+	Node
+	functionDefinitionChild()
+}
+
+type FunctionDefinition struct {
+	ListNode
+}
+
+func NewFunctionDefinition() *FunctionDefinition {
+	node := &FunctionDefinition{}
+	node.initBaseNode(symbol.FUNCDEF)
+	node.initListNode()
+	return node
+}
+
+func (node *FunctionDefinition) asyncFunctionDefinitionChild()    {}
+func (node *FunctionDefinition) asyncStatementChild()             {}
+func (node *FunctionDefinition) compoundStatementChild()          {}
+func (node *FunctionDefinition) decoratedChild()                  {}
+func (node *FunctionDefinition) Append(n FunctionDefinitionChild) { node.ListNode.Append(n) }
+
+type ParametersChild interface {
+	Node
+	parametersChild()
+}
+
+type Parameters struct {
+	ListNode
+}
+
+func NewParameters() *Parameters {
+	node := &Parameters{}
+	node.initBaseNode(symbol.PARAMETERS)
+	node.initListNode()
+	return node
+}
+
+func (node *Parameters) functionDefinitionChild() {}
+func (node *Parameters) Append(n ParametersChild) { node.ListNode.Append(n) }
+
+type TypedArgsListChild interface {
+	Node
+	typedArgsListChild()
+}
+
+type TypedArgsList struct {
+	ListNode
+}
+
+func NewTypedArgsList() *TypedArgsList {
+	node := &TypedArgsList{}
+	node.initBaseNode(symbol.TYPEDARGSLIST)
+	node.initListNode()
+	return node
+}
+
+func (node *TypedArgsList) parametersChild()            {}
+func (node *TypedArgsList) Append(n TypedArgsListChild) { node.ListNode.Append(n) }
+
+type TypedFunctionParameterChild interface {
+	Node
+	typedFunctionParameterChild()
+}
+
+type TypedFunctionParameter struct {
+	ListNode
+}
+
+func NewTypedFunctionParameter() *TypedFunctionParameter {
+	node := &TypedFunctionParameter{}
+	node.initBaseNode(symbol.TFPDEF)
+	node.initListNode()
+	return node
+}
+
+func (node *TypedFunctionParameter) typedArgsListChild()                  {}
+func (node *TypedFunctionParameter) Append(n TypedFunctionParameterChild) { node.ListNode.Append(n) }
+
+type VarArgsListChild interface {
+	Node
+	varArgsListChild()
+}
+
+type VarArgsList struct {
+	ListNode
+}
+
+func NewVarArgsList() *VarArgsList {
+	node := &VarArgsList{}
+	node.initBaseNode(symbol.VARARGSLIST)
+	node.initListNode()
+	return node
+}
+
+func (node *VarArgsList) lambdaDefinitionChild()       {}
+func (node *VarArgsList) lambdaDefinitionNocondChild() {}
+func (node *VarArgsList) Append(n VarArgsListChild)    { node.ListNode.Append(n) }
+
+type VarFunctionParameterChild interface {
+	Node
+	varFunctionParameterChild()
+}
+
+type VarFunctionParameter struct {
+	ListNode
+}
+
+func NewVarFunctionParameter() *VarFunctionParameter {
+	node := &VarFunctionParameter{}
+	node.initBaseNode(symbol.VFPDEF)
+	node.initListNode()
+	return node
+}
+
+func (node *VarFunctionParameter) varArgsListChild()                  {}
+func (node *VarFunctionParameter) Append(n VarFunctionParameterChild) { node.ListNode.Append(n) }
+
+type ClassDefinitionChild interface {
+	Node
+	classDefinitionChild()
+}
+
+type ClassDefinition struct {
+	ListNode
+}
+
+func NewClassDefinition() *ClassDefinition {
+	node := &ClassDefinition{}
+	node.initBaseNode(symbol.CLASSDEF)
+	node.initListNode()
+	return node
+}
+
+func (node *ClassDefinition) compoundStatementChild()       {}
+func (node *ClassDefinition) decoratedChild()               {}
+func (node *ClassDefinition) Append(n ClassDefinitionChild) { node.ListNode.Append(n) }
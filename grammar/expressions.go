@@ -11,17 +11,15 @@ type TestlistStarExpression struct {
 	ListNode
 }
 
-func NewTestListStarExpression() *TestlistStarExpression {
+func NewTestlistStarExpression() *TestlistStarExpression {
 	node := &TestlistStarExpression{}
 	node.initBaseNode(symbol.TESTLIST_STAR_EXPR)
 	node.initListNode()
 	return node
 }
 
-func (node *TestlistStarExpression) expressionStatementChild() {}
-func (node *TestlistStarExpression) Append(n TestlistStarExpressionChild) {
-	node.ListNode.Append(n)
-}
+func (node *TestlistStarExpression) expressionStatementChild()            {}
+func (node *TestlistStarExpression) Append(n TestlistStarExpressionChild) { node.ListNode.Append(n) }
 
 type ComparisonChild interface {
 	Node
@@ -42,6 +40,47 @@ func NewComparison() *Comparison {
 func (node *Comparison) notTestChild()            {}
 func (node *Comparison) Append(n ComparisonChild) { node.ListNode.Append(n) }
 
+type CompareOperatorChild interface {
+	Node
+	compareOperatorChild()
+}
+
+type CompareOperator struct {
+	ListNode
+}
+
+func NewCompareOperator() *CompareOperator {
+	node := &CompareOperator{}
+	node.initBaseNode(symbol.COMP_OP)
+	node.initListNode()
+	return node
+}
+
+func (node *CompareOperator) comparisonChild()              {}
+func (node *CompareOperator) Append(n CompareOperatorChild) { node.ListNode.Append(n) }
+
+type StarExpressionChild interface {
+	Node
+	starExpressionChild()
+}
+
+type StarExpression struct {
+	ListNode
+}
+
+func NewStarExpression() *StarExpression {
+	node := &StarExpression{}
+	node.initBaseNode(symbol.STAR_EXPR)
+	node.initListNode()
+	return node
+}
+
+func (node *StarExpression) dictOrSetMakerChild()         {}
+func (node *StarExpression) expressionListChild()         {}
+func (node *StarExpression) testlistComprehensionChild()  {}
+func (node *StarExpression) testlistStarExpressionChild() {}
+func (node *StarExpression) Append(n StarExpressionChild) { node.ListNode.Append(n) }
+
 type ExpressionChild interface {
 	Node
 	expressionChild()
@@ -59,6 +98,10 @@ func NewExpression() *Expression {
 }
 
 func (node *Expression) comparisonChild()         {}
+func (node *Expression) dictOrSetMakerChild()     {}
+func (node *Expression) expressionListChild()     {}
+func (node *Expression) starExpressionChild()     {}
+func (node *Expression) withItemChild()           {}
 func (node *Expression) Append(n ExpressionChild) { node.ListNode.Append(n) }
 
 type XorExpressionChild interface {
@@ -234,6 +277,25 @@ func NewAtom() *Atom {
 func (node *Atom) atomExpressionChild() {}
 func (node *Atom) Append(n AtomChild)   { node.ListNode.Append(n) }
 
+type TestlistComprehensionChild interface {
+	Node
+	testlistComprehensionChild()
+}
+
+type TestlistComprehension struct {
+	ListNode
+}
+
+func NewTestlistComprehension() *TestlistComprehension {
+	node := &TestlistComprehension{}
+	node.initBaseNode(symbol.TESTLIST_COMP)
+	node.initListNode()
+	return node
+}
+
+func (node *TestlistComprehension) atomChild()                          {}
+func (node *TestlistComprehension) Append(n TestlistComprehensionChild) { node.ListNode.Append(n) }
+
 type TrailerChild interface {
 	Node
 	trailerChild()
@@ -252,3 +314,202 @@ func NewTrailer() *Trailer {
 
 func (node *Trailer) atomExpressionChild()  {}
 func (node *Trailer) Append(n TrailerChild) { node.ListNode.Append(n) }
+
+type SubscriptListChild interface {
+	Node
+	subscriptListChild()
+}
+
+type SubscriptList struct {
+	ListNode
+}
+
+func NewSubscriptList() *SubscriptList {
+	node := &SubscriptList{}
+	node.initBaseNode(symbol.SUBSCRIPTLIST)
+	node.initListNode()
+	return node
+}
+
+func (node *SubscriptList) trailerChild()               {}
+func (node *SubscriptList) Append(n SubscriptListChild) { node.ListNode.Append(n) }
+
+type SubscriptChild interface {
+	Node
+	subscriptChild()
+}
+
+type Subscript struct {
+	ListNode
+}
+
+func NewSubscript() *Subscript {
+	node := &Subscript{}
+	node.initBaseNode(symbol.SUBSCRIPT)
+	node.initListNode()
+	return node
+}
+
+func (node *Subscript) subscriptListChild()     {}
+func (node *Subscript) Append(n SubscriptChild) { node.ListNode.Append(n) }
+
+type SliceOperationChild interface {
+	Node
+	sliceOperationChild()
+}
+
+type SliceOperation struct {
+	ListNode
+}
+
+func NewSliceOperation() *SliceOperation {
+	node := &SliceOperation{}
+	node.initBaseNode(symbol.SLICEOP)
+	node.initListNode()
+	return node
+}
+
+func (node *SliceOperation) subscriptChild()              {}
+func (node *SliceOperation) Append(n SliceOperationChild) { node.ListNode.Append(n) }
+
+type ExpressionListChild interface {
+	Node
+	expressionListChild()
+}
+
+type ExpressionList struct {
+	ListNode
+}
+
+func NewExpressionList() *ExpressionList {
+	node := &ExpressionList{}
+	node.initBaseNode(symbol.EXPRLIST)
+	node.initListNode()
+	return node
+}
+
+func (node *ExpressionList) comprehensionForChild()       {}
+func (node *ExpressionList) deleteStatementChild()        {}
+func (node *ExpressionList) forStatementChild()           {}
+func (node *ExpressionList) Append(n ExpressionListChild) { node.ListNode.Append(n) }
+
+type TestlistChild interface {
+	Node
+	testlistChild()
+}
+
+type Testlist struct {
+	ListNode
+}
+
+func NewTestlist() *Testlist {
+	node := &Testlist{}
+	node.initBaseNode(symbol.TESTLIST)
+	node.initListNode()
+	return node
+}
+
+func (node *Testlist) expressionStatementChild() {}
+func (node *Testlist) forStatementChild()        {}
+func (node *Testlist) returnStatementChild()     {}
+func (node *Testlist) yieldArgumentChild()       {}
+func (node *Testlist) Append(n TestlistChild)    { node.ListNode.Append(n) }
+
+type DictOrSetMakerChild interface {
+	Node
+	dictOrSetMakerChild()
+}
+
+type DictOrSetMaker struct {
+	ListNode
+}
+
+func NewDictOrSetMaker() *DictOrSetMaker {
+	node := &DictOrSetMaker{}
+	node.initBaseNode(symbol.DICTORSETMAKER)
+	node.initListNode()
+	return node
+}
+
+func (node *DictOrSetMaker) atomChild()                   {}
+func (node *DictOrSetMaker) Append(n DictOrSetMakerChild) { node.ListNode.Append(n) }
+
+type ArgumentListChild interface {
+	Node
+	argumentListChild()
+}
+
+type ArgumentList struct {
+	ListNode
+}
+
+func NewArgumentList() *ArgumentList {
+	node := &ArgumentList{}
+	node.initBaseNode(symbol.ARGLIST)
+	node.initListNode()
+	return node
+}
+
+func (node *ArgumentList) classDefinitionChild()      {}
+func (node *ArgumentList) decoratorChild()            {}
+func (node *ArgumentList) trailerChild()              {}
+func (node *ArgumentList) Append(n ArgumentListChild) { node.ListNode.Append(n) }
+
+type ArgumentChild interface {
+	Node
+	argumentChild()
+}
+
+type Argument struct {
+	ListNode
+}
+
+func NewArgument() *Argument {
+	node := &Argument{}
+	node.initBaseNode(symbol.ARGUMENT)
+	node.initListNode()
+	return node
+}
+
+func (node *Argument) argumentListChild()     {}
+func (node *Argument) Append(n ArgumentChild) { node.ListNode.Append(n) }
+
+type YieldExpressionChild interface {
+	Node
+	yieldExpressionChild()
+}
+
+type YieldExpression struct {
+	ListNode
+}
+
+func NewYieldExpression() *YieldExpression {
+	node := &YieldExpression{}
+	node.initBaseNode(symbol.YIELD_EXPR)
+	node.initListNode()
+	return node
+}
+
+func (node *YieldExpression) atomChild()                    {}
+func (node *YieldExpression) expressionStatementChild()     {}
+func (node *YieldExpression) yieldStatementChild()          {}
+func (node *YieldExpression) Append(n YieldExpressionChild) { node.ListNode.Append(n) }
+
+type YieldArgumentChild interface {
+	Node
+	yieldArgumentChild()
+}
+
+type YieldArgument struct {
+	ListNode
+}
+
+func NewYieldArgument() *YieldArgument {
+	node := &YieldArgument{}
+	node.initBaseNode(symbol.YIELD_ARG)
+	node.initListNode()
+	return node
+}
+
+func (node *YieldArgument) yieldExpressionChild()       {}
+func (node *YieldArgument) Append(n YieldArgumentChild) { node.ListNode.Append(n) }
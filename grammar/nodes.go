@@ -22,17 +22,80 @@ func NewTokenNode(tok *token.Token) *TokenNode {
 		Token: tok,
 	}
 }
-func (node *TokenNode) atomChild()                {}
-func (node *TokenNode) atomExpressionChild()      {}
-func (node *TokenNode) comparisonChild()          {}
-func (node *TokenNode) expressionStatementChild() {}
-func (node *TokenNode) factorChild()              {}
-func (node *TokenNode) fileInputChild()           {}
-func (node *TokenNode) shiftExpressionChild()     {}
-func (node *TokenNode) simpleStatementChild()     {}
-func (node *TokenNode) trailerChild()             {}
-func (node *TokenNode) ID() symbol.SymbolID       { return 0 }
-func (node *TokenNode) Name() string              { return token.TokenNames[node.Token.ID] }
+func (node *TokenNode) andExpressionChild()           {}
+func (node *TokenNode) andTestChild()                 {}
+func (node *TokenNode) annotatedAssignmentChild()     {}
+func (node *TokenNode) argumentChild()                {}
+func (node *TokenNode) argumentListChild()            {}
+func (node *TokenNode) arithmeticExpressionChild()    {}
+func (node *TokenNode) assertStatementChild()         {}
+func (node *TokenNode) asyncFunctionDefinitionChild() {}
+func (node *TokenNode) asyncStatementChild()          {}
+func (node *TokenNode) atomChild()                    {}
+func (node *TokenNode) atomExpressionChild()          {}
+func (node *TokenNode) augmentedAssignmentChild()     {}
+func (node *TokenNode) breakStatementChild()          {}
+func (node *TokenNode) classDefinitionChild()         {}
+func (node *TokenNode) compareOperatorChild()         {}
+func (node *TokenNode) comprehensionForChild()        {}
+func (node *TokenNode) comprehensionIfChild()         {}
+func (node *TokenNode) continueStatementChild()       {}
+func (node *TokenNode) decoratorChild()               {}
+func (node *TokenNode) deleteStatementChild()         {}
+func (node *TokenNode) dictOrSetMakerChild()          {}
+func (node *TokenNode) dottedAsNameChild()            {}
+func (node *TokenNode) dottedAsNamesChild()           {}
+func (node *TokenNode) dottedNameChild()              {}
+func (node *TokenNode) exceptClauseChild()            {}
+func (node *TokenNode) expressionChild()              {}
+func (node *TokenNode) expressionListChild()          {}
+func (node *TokenNode) expressionStatementChild()     {}
+func (node *TokenNode) factorChild()                  {}
+func (node *TokenNode) fileInputChild()               {}
+func (node *TokenNode) forStatementChild()            {}
+func (node *TokenNode) functionDefinitionChild()      {}
+func (node *TokenNode) globalStatementChild()         {}
+func (node *TokenNode) ifStatementChild()             {}
+func (node *TokenNode) importAsNameChild()            {}
+func (node *TokenNode) importAsNamesChild()           {}
+func (node *TokenNode) importFromChild()              {}
+func (node *TokenNode) importNameChild()              {}
+func (node *TokenNode) lambdaDefinitionChild()        {}
+func (node *TokenNode) lambdaDefinitionNocondChild()  {}
+func (node *TokenNode) nonlocalStatementChild()       {}
+func (node *TokenNode) notTestChild()                 {}
+func (node *TokenNode) orTestChild()                  {}
+func (node *TokenNode) parametersChild()              {}
+func (node *TokenNode) passStatementChild()           {}
+func (node *TokenNode) powerChild()                   {}
+func (node *TokenNode) raiseStatementChild()          {}
+func (node *TokenNode) returnStatementChild()         {}
+func (node *TokenNode) shiftExpressionChild()         {}
+func (node *TokenNode) simpleStatementChild()         {}
+func (node *TokenNode) sliceOperationChild()          {}
+func (node *TokenNode) starExpressionChild()          {}
+func (node *TokenNode) subscriptChild()               {}
+func (node *TokenNode) subscriptListChild()           {}
+func (node *TokenNode) suiteChild()                   {}
+func (node *TokenNode) termChild()                    {}
+func (node *TokenNode) testChild()                    {}
+func (node *TokenNode) testlistChild()                {}
+func (node *TokenNode) testlistComprehensionChild()   {}
+func (node *TokenNode) testlistStarExpressionChild()  {}
+func (node *TokenNode) trailerChild()                 {}
+func (node *TokenNode) tryStatementChild()            {}
+func (node *TokenNode) typedArgsListChild()           {}
+func (node *TokenNode) typedFunctionParameterChild()  {}
+func (node *TokenNode) varArgsListChild()             {}
+func (node *TokenNode) varFunctionParameterChild()    {}
+func (node *TokenNode) whileStatementChild()          {}
+func (node *TokenNode) withItemChild()                {}
+func (node *TokenNode) withStatementChild()           {}
+func (node *TokenNode) xorExpressionChild()           {}
+func (node *TokenNode) yieldArgumentChild()           {}
+func (node *TokenNode) yieldExpressionChild()         {}
+func (node *TokenNode) ID() symbol.SymbolID           { return 0 }
+func (node *TokenNode) Name() string                  { return token.TokenNames[node.Token.ID] }
 func (node *TokenNode) Repr() []interface{} {
 	parts := make([]interface{}, 0)
 	parts = append(parts, node.Name())
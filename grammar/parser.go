@@ -1,31 +1,97 @@
 package grammar
 
 import (
+	"context"
+
 	"github.com/brettlangdon/gython/error"
 	"github.com/brettlangdon/gython/errorcode"
 	"github.com/brettlangdon/gython/scanner"
 	"github.com/brettlangdon/gython/token"
 )
 
+// keywords are the NAME literals which the grammar reserves
+var keywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true,
+	"assert": true, "break": true, "class": true, "continue": true, "def": true,
+	"del": true, "elif": true, "else": true, "except": true, "finally": true,
+	"for": true, "from": true, "global": true, "if": true, "import": true,
+	"in": true, "is": true, "lambda": true, "nonlocal": true, "not": true,
+	"or": true, "pass": true, "raise": true, "return": true, "try": true,
+	"while": true, "with": true, "yield": true,
+}
+
+// MAXSTACK limits how deeply the rules which recurse into themselves nest, like the
+// parser stack of CPython, so a long chain like "- - - 1" can't run out of stack
+var MAXSTACK int = 1500
+
 type GrammarParser struct {
-	Errors      []*error.Error
-	tokenizer   *scanner.Scanner
-	tokenBuffer []*token.Token
+	Errors     []*error.Error
+	depth      int
+	scanFailed bool
+	tokenizer  *scanner.Scanner
+	tokens     *scanner.TokenStream
 }
 
 func (parser *GrammarParser) nextToken() *token.Token {
-	if len(parser.tokenBuffer) > 0 {
-		last := len(parser.tokenBuffer) - 1
-		next := parser.tokenBuffer[last]
-		parser.tokenBuffer = parser.tokenBuffer[:last]
-		return next
+	next := parser.tokens.Next()
+	if next.ID == token.ERRORTOKEN && !parser.scanFailed {
+		parser.scanFailed = true
+		parser.addScanError(parser.tokens.ScanError())
+	}
+	return next
+}
+
+// peekToken returns the next token without consuming it
+func (parser *GrammarParser) peekToken() *token.Token {
+	return parser.tokens.Peek(0)
+}
+
+// peekLiteral reports whether the next token is the keyword or name literal
+func (parser *GrammarParser) peekLiteral(literal string) bool {
+	return parser.peekToken().IsLiteral(literal)
+}
+
+// startsExpression reports whether the next token can start an expr
+func (parser *GrammarParser) startsExpression() bool {
+	next := parser.peekToken()
+	switch next.ID {
+	case token.NUMBER, token.STRING, token.LPAR, token.LSQB, token.LBRACE,
+		token.MINUS, token.PLUS, token.TILDE, token.ELLIPSIS, token.AWAIT:
+		return true
+	case token.NAME:
+		switch next.Literal {
+		case "None", "True", "False":
+			return true
+		}
+		return !keywords[next.Literal]
 	}
+	return false
+}
+
+// startsTest reports whether the next token can start a test
+func (parser *GrammarParser) startsTest() bool {
+	return parser.startsExpression() || parser.peekLiteral("not") || parser.peekLiteral("lambda")
+}
 
-	return parser.tokenizer.NextToken()
+// enter starts a rule which can nest, it records an error and returns false when there are
+// already MAXSTACK of them. Each successful enter is followed by a leave.
+func (parser *GrammarParser) enter() bool {
+	if parser.depth >= MAXSTACK {
+		tok := parser.peekToken()
+		parser.Errors = append(parser.Errors, &error.Error{
+			Code:    errorcode.E_NOMEM,
+			Message: "s_push: parser stack overflow",
+			Line:    tok.LineStart,
+			Column:  tok.ColumnStart,
+		})
+		return false
+	}
+	parser.depth++
+	return true
 }
 
-func (parser *GrammarParser) unreadToken(tok *token.Token) {
-	parser.tokenBuffer = append(parser.tokenBuffer, tok)
+func (parser *GrammarParser) leave() {
+	parser.depth--
 }
 
 func (parser *GrammarParser) addError(msg string) {
@@ -34,571 +100,2286 @@ func (parser *GrammarParser) addError(msg string) {
 	})
 }
 
-func (parser *GrammarParser) expect(tokID token.TokenID) bool {
-	next := parser.nextToken()
+func (parser *GrammarParser) addTokenError(msg string, tok *token.Token) {
+	parser.Errors = append(parser.Errors, &error.Error{
+		Code:    errorcode.E_SYNTAX,
+		Message: msg,
+		Line:    tok.LineStart,
+		Column:  tok.ColumnStart,
+	})
+}
+
+func (parser *GrammarParser) addScanError(err *scanner.ScanError) {
+	if err == nil {
+		return
+	}
+	parser.Errors = append(parser.Errors, &error.Error{
+		Code:    err.Code,
+		Message: err.Message,
+		Line:    err.Line,
+		Column:  err.Column,
+	})
+}
+
+// syntaxError records the error CPython reports when the parser finds tok where the grammar does not allow it
+func (parser *GrammarParser) syntaxError(tok *token.Token) {
+	switch tok.ID {
+	case token.ERRORTOKEN:
+		// Consuming the token records why the scanner failed
+		parser.nextToken()
+	case token.ENDMARKER:
+		parser.Errors = append(parser.Errors, &error.Error{
+			Code:    errorcode.E_EOF,
+			Message: errorcode.E_EOF.Message(),
+			Line:    tok.LineStart,
+			Column:  tok.ColumnStart,
+		})
+	case token.INDENT:
+		parser.addTokenError("unexpected indent", tok)
+	case token.DEDENT:
+		parser.addTokenError("unexpected unindent", tok)
+	default:
+		parser.addTokenError(errorcode.E_SYNTAX.Message(), tok)
+	}
+}
+
+// expect consumes the next token if it is a tokID, otherwise it records a syntax error and returns nil
+func (parser *GrammarParser) expect(tokID token.TokenID) *TokenNode {
+	next := parser.peekToken()
 	if next.ID != tokID {
-		msg := "Unexpected token \"" + next.ID.String() + "\" expected \"" + tokID.String() + "\""
-		parser.addError(msg)
-		return false
+		if tokID == token.INDENT && next.ID != token.ERRORTOKEN {
+			parser.addTokenError("expected an indented block", next)
+		} else {
+			parser.syntaxError(next)
+		}
+		return nil
 	}
-	return true
+	return NewTokenNode(parser.nextToken())
 }
 
-func (parser *GrammarParser) expectLiteral(literal string) bool {
-	next := parser.nextToken()
+// expectLiteral consumes the next token if it is the keyword literal, otherwise it records a syntax error and returns nil
+func (parser *GrammarParser) expectLiteral(literal string) *TokenNode {
+	next := parser.peekToken()
 	if !next.IsLiteral(literal) {
-		msg := "Unexpected literal \"" + next.Literal + "\" expected \"" + literal + "\""
-		parser.addError(msg)
-		return false
+		parser.syntaxError(next)
+		return nil
 	}
-	return true
+	return NewTokenNode(parser.nextToken())
 }
 
-// compound_stmt: if_stmt | while_stmt | for_stmt | try_stmt | with_stmt | funcdef | classdef | decorated | async_stmt
-func (parser *GrammarParser) parseCompoundStatement() *CompoundStatement {
-	compoundStmt := NewCompoundStatement()
-	return compoundStmt
+// expectName consumes the next token if it is a NAME which is not a keyword
+func (parser *GrammarParser) expectName() *TokenNode {
+	next := parser.peekToken()
+	if next.ID != token.NAME || keywords[next.Literal] {
+		parser.syntaxError(next)
+		return nil
+	}
+	return NewTokenNode(parser.nextToken())
 }
 
-// atom: ('(' [yield_expr|testlist_comp] ')' |
-//        '[' [testlist_comp] ']' |
-//        '{' [dictorsetmaker] '}' |
-//        NAME | NUMBER | STRING+ | '...' | 'None' | 'True' | 'False')
-func (parser *GrammarParser) parseAtom() *Atom {
-	atom := NewAtom()
-	next := parser.nextToken()
-	switch next.ID {
-	case token.NAME, token.NUMBER, token.ELLIPSIS:
-		atom.Append(NewTokenNode(next))
-	case token.STRING:
-		atom.Append(NewTokenNode(next))
-		for {
-			next := parser.nextToken()
-			if next.ID != token.STRING {
-				parser.unreadToken(next)
-				break
+// decorator: '@' dotted_name [ '(' [arglist] ')' ] NEWLINE
+func (parser *GrammarParser) parseDecorator() *Decorator {
+	decorator := NewDecorator()
+	at := parser.expect(token.AT)
+	if at == nil {
+		return nil
+	}
+	decorator.Append(at)
+
+	name := parser.parseDottedName()
+	if name == nil {
+		return nil
+	}
+	decorator.Append(name)
+
+	if next := parser.peekToken(); next.ID == token.LPAR {
+		decorator.Append(NewTokenNode(parser.nextToken()))
+		if parser.peekToken().ID != token.RPAR {
+			args := parser.parseArgumentList()
+			if args == nil {
+				return nil
 			}
-			atom.Append(NewTokenNode(next))
+			decorator.Append(args)
+		}
+		rpar := parser.expect(token.RPAR)
+		if rpar == nil {
+			return nil
 		}
+		decorator.Append(rpar)
 	}
-	return atom
+
+	newline := parser.expect(token.NEWLINE)
+	if newline == nil {
+		return nil
+	}
+	decorator.Append(newline)
+	return decorator
 }
 
-// trailer: '(' [arglist] ')' | '[' subscriptlist ']' | '.' NAME
-func (parser *GrammarParser) parseTrailer() *Trailer {
-	trailer := NewTrailer()
-	next := parser.nextToken()
-	switch next.ID {
-	case token.LPAR:
-		next2 := parser.nextToken()
-		if next2.ID != token.RPAR {
+// decorators: decorator+
+func (parser *GrammarParser) parseDecorators() *Decorators {
+	decorators := NewDecorators()
+	for {
+		decorator := parser.parseDecorator()
+		if decorator == nil {
 			return nil
 		}
-		trailer.Append(NewTokenNode(next))
-		trailer.Append(NewTokenNode(next2))
-	case token.LBRACE:
-		next2 := parser.nextToken()
-		if next2.ID != token.RBRACE {
+		decorators.Append(decorator)
+		if parser.peekToken().ID != token.AT {
+			break
+		}
+	}
+	return decorators
+}
+
+// decorated: decorators (classdef | funcdef | async_funcdef)
+func (parser *GrammarParser) parseDecorated() *Decorated {
+	decorated := NewDecorated()
+	decorators := parser.parseDecorators()
+	if decorators == nil {
+		return nil
+	}
+	decorated.Append(decorators)
+
+	next := parser.peekToken()
+	switch {
+	case next.IsLiteral("class"):
+		classDef := parser.parseClassDefinition()
+		if classDef == nil {
 			return nil
 		}
-		trailer.Append(NewTokenNode(next))
-		trailer.Append(NewTokenNode(next2))
-	case token.DOT:
-		next2 := parser.nextToken()
-		if next2.ID == token.NAME {
-			trailer.Append(NewTokenNode(next))
-			trailer.Append(NewTokenNode(next2))
-		} else {
-			parser.addError("Expected \"NAME\" instead found \"" + next.ID.String() + "\"")
+		decorated.Append(classDef)
+	case next.IsLiteral("def"):
+		funcDef := parser.parseFunctionDefinition()
+		if funcDef == nil {
+			return nil
+		}
+		decorated.Append(funcDef)
+	case next.ID == token.ASYNC:
+		asyncFuncDef := parser.parseAsyncFunctionDefinition()
+		if asyncFuncDef == nil {
 			return nil
 		}
+		decorated.Append(asyncFuncDef)
 	default:
-		parser.unreadToken(next)
+		parser.syntaxError(next)
 		return nil
 	}
-	return trailer
+	return decorated
 }
 
-// atom_expr: [AWAIT] atom trailer*
-func (parser *GrammarParser) parseAtomExpression() *AtomExpression {
-	expr := NewAtomExpression()
-	next := parser.nextToken()
-	if next.ID == token.AWAIT {
-		expr.Append(NewTokenNode(next))
-	} else {
-		parser.unreadToken(next)
+// async_funcdef: ASYNC funcdef
+func (parser *GrammarParser) parseAsyncFunctionDefinition() *AsyncFunctionDefinition {
+	asyncFuncDef := NewAsyncFunctionDefinition()
+	async := parser.expect(token.ASYNC)
+	if async == nil {
+		return nil
 	}
+	asyncFuncDef.Append(async)
 
-	atom := parser.parseAtom()
-	if atom == nil {
+	funcDef := parser.parseFunctionDefinition()
+	if funcDef == nil {
 		return nil
 	}
-	expr.Append(atom)
-	for {
-		trailer := parser.parseTrailer()
-		if trailer == nil {
-			break
+	asyncFuncDef.Append(funcDef)
+	return asyncFuncDef
+}
+
+// funcdef: 'def' NAME parameters ['->' test] ':' suite
+func (parser *GrammarParser) parseFunctionDefinition() *FunctionDefinition {
+	funcDef := NewFunctionDefinition()
+	def := parser.expectLiteral("def")
+	if def == nil {
+		return nil
+	}
+	funcDef.Append(def)
+
+	name := parser.expectName()
+	if name == nil {
+		return nil
+	}
+	funcDef.Append(name)
+
+	params := parser.parseParameters()
+	if params == nil {
+		return nil
+	}
+	funcDef.Append(params)
+
+	if next := parser.peekToken(); next.ID == token.RARROW {
+		funcDef.Append(NewTokenNode(parser.nextToken()))
+		test := parser.parseTest()
+		if test == nil {
+			return nil
 		}
-		expr.Append(trailer)
+		funcDef.Append(test)
 	}
 
-	return expr
+	colon := parser.expect(token.COLON)
+	if colon == nil {
+		return nil
+	}
+	funcDef.Append(colon)
+
+	suite := parser.parseSuite()
+	if suite == nil {
+		return nil
+	}
+	funcDef.Append(suite)
+	return funcDef
 }
 
-// power: atom_expr ['**' factor]
-func (parser *GrammarParser) parsePower() *Power {
-	power := NewPower()
-	atomExpr := parser.parseAtomExpression()
-	if atomExpr == nil {
+// parameters: '(' [typedargslist] ')'
+func (parser *GrammarParser) parseParameters() *Parameters {
+	params := NewParameters()
+	lpar := parser.expect(token.LPAR)
+	if lpar == nil {
 		return nil
 	}
-	power.Append(atomExpr)
+	params.Append(lpar)
 
-	next := parser.nextToken()
-	if next.ID == token.DOUBLESTAR {
-		factor := parser.parseFactor()
-		if factor == nil {
+	if parser.peekToken().ID != token.RPAR {
+		args := parser.parseTypedArgsList()
+		if args == nil {
 			return nil
-		} else {
-			power.Append(factor)
 		}
-	} else {
-		parser.unreadToken(next)
+		params.Append(args)
 	}
-	return power
+
+	rpar := parser.expect(token.RPAR)
+	if rpar == nil {
+		return nil
+	}
+	params.Append(rpar)
+	return params
 }
 
-// factor: ('+'|'-'|'~') factor | power
-func (parser *GrammarParser) parseFactor() *Factor {
-	factor := NewFactor()
-	next := parser.nextToken()
-	switch next.ID {
-	case token.PLUS, token.MINUS, token.TILDE:
-		node := parser.parseFactor()
-		if node == nil {
-			return nil
+// typedargslist: (tfpdef ['=' test] (',' tfpdef ['=' test])* [','
+//        ['*' [tfpdef] (',' tfpdef ['=' test])* [',' '**' tfpdef] | '**' tfpdef]]
+//      |  '*' [tfpdef] (',' tfpdef ['=' test])* [',' '**' tfpdef] | '**' tfpdef)
+func (parser *GrammarParser) parseTypedArgsList() *TypedArgsList {
+	args := NewTypedArgsList()
+	star := false
+	for {
+		next := parser.peekToken()
+		switch {
+		case next.ID == token.STAR && !star:
+			star = true
+			args.Append(NewTokenNode(parser.nextToken()))
+			if parser.peekToken().ID == token.NAME {
+				param := parser.parseTypedFunctionParameter()
+				if param == nil {
+					return nil
+				}
+				args.Append(param)
+			}
+		case next.ID == token.DOUBLESTAR:
+			args.Append(NewTokenNode(parser.nextToken()))
+			param := parser.parseTypedFunctionParameter()
+			if param == nil {
+				return nil
+			}
+			args.Append(param)
+			// Nothing but a trailing comma may follow the '**' parameter
+			if parser.tokenizer.Version().AtLeast(scanner.Python36) && parser.peekToken().ID == token.COMMA {
+				args.Append(NewTokenNode(parser.nextToken()))
+			}
+			return args
+		default:
+			param := parser.parseTypedFunctionParameter()
+			if param == nil {
+				return nil
+			}
+			args.Append(param)
+			if parser.peekToken().ID == token.EQUAL {
+				args.Append(NewTokenNode(parser.nextToken()))
+				test := parser.parseTest()
+				if test == nil {
+					return nil
+				}
+				args.Append(test)
+			}
 		}
-		factor.Append(NewTokenNode(next))
-		factor.Append(node)
-	default:
-		parser.unreadToken(next)
-		power := parser.parsePower()
-		if power == nil {
-			return nil
+
+		if parser.peekToken().ID != token.COMMA {
+			break
+		}
+		args.Append(NewTokenNode(parser.nextToken()))
+		// Python 3.5 only allows a trailing comma before the '*' parameter
+		if (!star || parser.tokenizer.Version().AtLeast(scanner.Python36)) && parser.peekToken().ID == token.RPAR {
+			break
 		}
-		factor.Append(power)
 	}
-
-	return factor
+	return args
 }
 
-// term: factor (('*'|'@'|'/'|'%'|'//') factor)*
-func (parser *GrammarParser) parseTerm() *Term {
-	term := NewTerm()
-	factor := parser.parseFactor()
-	if factor == nil {
+// tfpdef: NAME [':' test]
+func (parser *GrammarParser) parseTypedFunctionParameter() *TypedFunctionParameter {
+	param := NewTypedFunctionParameter()
+	name := parser.expectName()
+	if name == nil {
 		return nil
 	}
-	term.Append(factor)
+	param.Append(name)
+
+	if next := parser.peekToken(); next.ID == token.COLON {
+		param.Append(NewTokenNode(parser.nextToken()))
+		test := parser.parseTest()
+		if test == nil {
+			return nil
+		}
+		param.Append(test)
+	}
+	return param
+}
+
+// varargslist: (vfpdef ['=' test] (',' vfpdef ['=' test])* [','
+//        ['*' [vfpdef] (',' vfpdef ['=' test])* [',' '**' vfpdef] | '**' vfpdef]]
+//      |  '*' [vfpdef] (',' vfpdef ['=' test])* [',' '**' vfpdef] | '**' vfpdef)
+func (parser *GrammarParser) parseVarArgsList() *VarArgsList {
+	args := NewVarArgsList()
+	star := false
 	for {
-		next := parser.nextToken()
-		if next.ID != token.STAR && next.ID != token.AMPER && next.ID != token.SLASH && next.ID != token.PERCENT && next.ID != token.DOUBLESLASH {
-			parser.unreadToken(next)
+		next := parser.peekToken()
+		switch {
+		case next.ID == token.STAR && !star:
+			star = true
+			args.Append(NewTokenNode(parser.nextToken()))
+			if parser.peekToken().ID == token.NAME {
+				param := parser.parseVarFunctionParameter()
+				if param == nil {
+					return nil
+				}
+				args.Append(param)
+			}
+		case next.ID == token.DOUBLESTAR:
+			args.Append(NewTokenNode(parser.nextToken()))
+			param := parser.parseVarFunctionParameter()
+			if param == nil {
+				return nil
+			}
+			args.Append(param)
+			// Nothing but a trailing comma may follow the '**' parameter
+			if parser.tokenizer.Version().AtLeast(scanner.Python36) && parser.peekToken().ID == token.COMMA {
+				args.Append(NewTokenNode(parser.nextToken()))
+			}
+			return args
+		default:
+			param := parser.parseVarFunctionParameter()
+			if param == nil {
+				return nil
+			}
+			args.Append(param)
+			if parser.peekToken().ID == token.EQUAL {
+				args.Append(NewTokenNode(parser.nextToken()))
+				test := parser.parseTest()
+				if test == nil {
+					return nil
+				}
+				args.Append(test)
+			}
+		}
+
+		if parser.peekToken().ID != token.COMMA {
 			break
 		}
-		factor := parser.parseFactor()
-		if factor == nil {
-			return nil
+		args.Append(NewTokenNode(parser.nextToken()))
+		// Python 3.5 only allows a trailing comma before the '*' parameter
+		if (!star || parser.tokenizer.Version().AtLeast(scanner.Python36)) && parser.peekToken().ID == token.COLON {
+			break
 		}
-		term.Append(factor)
 	}
-	return term
+	return args
 }
 
-// arith_expr: term (('+'|'-') term)*
-func (parser *GrammarParser) parseArithmetricExpression() *ArithmeticExpression {
-	expr := NewArithmeticExpression()
-	term := parser.parseTerm()
-	if term == nil {
+// vfpdef: NAME
+func (parser *GrammarParser) parseVarFunctionParameter() *VarFunctionParameter {
+	param := NewVarFunctionParameter()
+	name := parser.expectName()
+	if name == nil {
 		return nil
 	}
-	expr.Append(term)
+	param.Append(name)
+	return param
+}
+
+// stmt: simple_stmt | compound_stmt
+func (parser *GrammarParser) parseStatement() *Statement {
+	stmt := NewStatement()
+	next := parser.peekToken()
+	switch {
+	case next.ID == token.AT, next.ID == token.ASYNC,
+		next.IsLiteral("if"), next.IsLiteral("while"), next.IsLiteral("for"), next.IsLiteral("try"),
+		next.IsLiteral("with"), next.IsLiteral("def"), next.IsLiteral("class"):
+		compoundStmt := parser.parseCompoundStatement()
+		if compoundStmt == nil {
+			return nil
+		}
+		stmt.SetChild(compoundStmt)
+	default:
+		simpleStmt := parser.parseSimpleStatement()
+		if simpleStmt == nil {
+			return nil
+		}
+		stmt.SetChild(simpleStmt)
+	}
+	return stmt
+}
+
+// simple_stmt: small_stmt (';' small_stmt)* [';'] NEWLINE
+func (parser *GrammarParser) parseSimpleStatement() *SimpleStatement {
+	simpleStmt := NewSimpleStatement()
 	for {
-		next := parser.nextToken()
-		if next.ID != token.PLUS || next.ID != token.MINUS {
-			parser.unreadToken(next)
+		smallStmt := parser.parseSmallStatement()
+		if smallStmt == nil {
+			return nil
+		}
+		simpleStmt.Append(smallStmt)
+		if parser.peekToken().ID != token.SEMI {
+			break
+		}
+		simpleStmt.Append(NewTokenNode(parser.nextToken()))
+		if parser.peekToken().ID == token.NEWLINE {
 			break
 		}
-		term := parser.parseTerm()
-		if term == nil {
+	}
+
+	newline := parser.expect(token.NEWLINE)
+	if newline == nil {
+		return nil
+	}
+	simpleStmt.Append(newline)
+	return simpleStmt
+}
+
+// small_stmt: (expr_stmt | del_stmt | pass_stmt | flow_stmt |
+//              import_stmt | global_stmt | nonlocal_stmt | assert_stmt)
+func (parser *GrammarParser) parseSmallStatement() *SmallStatement {
+	smallStmt := NewSmallStatement()
+
+	var stmt SmallStatementChild
+	next := parser.peekToken()
+	switch {
+	case next.IsLiteral("del"):
+		stmt = parser.parseDeleteStatement()
+	case next.IsLiteral("pass"):
+		stmt = parser.parsePassStatement()
+	case next.IsLiteral("break"), next.IsLiteral("continue"), next.IsLiteral("return"),
+		next.IsLiteral("raise"), next.IsLiteral("yield"):
+		stmt = parser.parseFlowStatement()
+	case next.IsLiteral("import"), next.IsLiteral("from"):
+		stmt = parser.parseImportStatement()
+	case next.IsLiteral("global"):
+		stmt = parser.parseGlobalStatement()
+	case next.IsLiteral("nonlocal"):
+		stmt = parser.parseNonlocalStatement()
+	case next.IsLiteral("assert"):
+		stmt = parser.parseAssertStatement()
+	default:
+		stmt = parser.parseExpressionStatement()
+	}
+
+	if len(parser.Errors) > 0 {
+		return nil
+	}
+	smallStmt.SetChild(stmt)
+	return smallStmt
+}
+
+// expr_stmt: testlist_star_expr (annassign | augassign (yield_expr|testlist) |
+//                      ('=' (yield_expr|testlist_star_expr))*)
+func (parser *GrammarParser) parseExpressionStatement() *ExpressionStatement {
+	exprStmt := NewExpressionStatement()
+	expr := parser.parseTestlistStarExpression()
+	if expr == nil {
+		return nil
+	}
+	exprStmt.Append(expr)
+
+	next := parser.peekToken()
+	switch next.ID {
+	case token.COLON:
+		annAssign := parser.parseAnnotatedAssignment()
+		if annAssign == nil {
+			return nil
+		}
+		exprStmt.Append(annAssign)
+	case token.PLUSEQUAL, token.MINEQUAL, token.STAREQUAL, token.ATEQUAL, token.SLASHEQUAL,
+		token.PERCENTEQUAL, token.AMPEREQUAL, token.VBAREQUAL, token.CIRCUMFLEXEQUAL,
+		token.LEFTSHIFTEQUAL, token.RIGHTSHIFTEQUAL, token.DOUBLESTAREQUAL, token.DOUBLESLASHEQUAL:
+		augAssign := parser.parseAugmentedAssignment()
+		exprStmt.Append(augAssign)
+		if parser.peekLiteral("yield") {
+			yieldExpr := parser.parseYieldExpression()
+			if yieldExpr == nil {
+				return nil
+			}
+			exprStmt.Append(yieldExpr)
+		} else {
+			testlist := parser.parseTestlist()
+			if testlist == nil {
+				return nil
+			}
+			exprStmt.Append(testlist)
+		}
+	default:
+		for parser.peekToken().ID == token.EQUAL {
+			exprStmt.Append(NewTokenNode(parser.nextToken()))
+			if parser.peekLiteral("yield") {
+				yieldExpr := parser.parseYieldExpression()
+				if yieldExpr == nil {
+					return nil
+				}
+				exprStmt.Append(yieldExpr)
+				continue
+			}
+			expr := parser.parseTestlistStarExpression()
+			if expr == nil {
+				return nil
+			}
+			exprStmt.Append(expr)
+		}
+	}
+
+	return exprStmt
+}
+
+// annassign: ':' test ['=' test]
+func (parser *GrammarParser) parseAnnotatedAssignment() *AnnotatedAssignment {
+	annAssign := NewAnnotatedAssignment()
+	next := parser.peekToken()
+	if next.ID != token.COLON {
+		return nil
+	}
+	parser.nextToken()
+	if !parser.tokenizer.Version().AtLeast(scanner.Python36) {
+		parser.addTokenError(scanner.FeatureMessage("variable annotation", scanner.Python36), next)
+		return nil
+	}
+	annAssign.Append(NewTokenNode(next))
+
+	test := parser.parseTest()
+	if test == nil {
+		return nil
+	}
+	annAssign.Append(test)
+
+	next = parser.peekToken()
+	if next.ID != token.EQUAL {
+		return annAssign
+	}
+	parser.nextToken()
+	annAssign.Append(NewTokenNode(next))
+	test = parser.parseTest()
+	if test == nil {
+		return nil
+	}
+	annAssign.Append(test)
+	return annAssign
+}
+
+// testlist_star_expr: (test|star_expr) (',' (test|star_expr))* [',']
+func (parser *GrammarParser) parseTestlistStarExpression() *TestlistStarExpression {
+	testlistStarExpression := NewTestlistStarExpression()
+	for {
+		if parser.peekToken().ID == token.STAR {
+			starExpr := parser.parseStarExpression()
+			if starExpr == nil {
+				return nil
+			}
+			testlistStarExpression.Append(starExpr)
+		} else {
+			test := parser.parseTest()
+			if test == nil {
+				return nil
+			}
+			testlistStarExpression.Append(test)
+		}
+
+		if parser.peekToken().ID != token.COMMA {
+			break
+		}
+		testlistStarExpression.Append(NewTokenNode(parser.nextToken()))
+		if !parser.startsTest() && parser.peekToken().ID != token.STAR {
+			break
+		}
+	}
+	return testlistStarExpression
+}
+
+// augassign: ('+=' | '-=' | '*=' | '@=' | '/=' | '%=' | '&=' | '|=' | '^=' |
+//             '<<=' | '>>=' | '**=' | '//=')
+func (parser *GrammarParser) parseAugmentedAssignment() *AugmentedAssignment {
+	augAssign := NewAugmentedAssignment()
+	augAssign.Append(NewTokenNode(parser.nextToken()))
+	return augAssign
+}
+
+// del_stmt: 'del' exprlist
+func (parser *GrammarParser) parseDeleteStatement() *DeleteStatement {
+	delStmt := NewDeleteStatement()
+	delStmt.Append(NewTokenNode(parser.nextToken()))
+	exprList := parser.parseExpressionList()
+	if exprList == nil {
+		return nil
+	}
+	delStmt.Append(exprList)
+	return delStmt
+}
+
+// pass_stmt: 'pass'
+func (parser *GrammarParser) parsePassStatement() *PassStatement {
+	passStmt := NewPassStatement()
+	passStmt.Append(NewTokenNode(parser.nextToken()))
+	return passStmt
+}
+
+// flow_stmt: break_stmt | continue_stmt | return_stmt | raise_stmt | yield_stmt
+func (parser *GrammarParser) parseFlowStatement() *FlowStatement {
+	flowStmt := NewFlowStatement()
+	next := parser.peekToken()
+	switch next.Literal {
+	case "break":
+		// break_stmt: 'break'
+		breakStmt := NewBreakStatement()
+		breakStmt.Append(NewTokenNode(parser.nextToken()))
+		flowStmt.SetChild(breakStmt)
+	case "continue":
+		// continue_stmt: 'continue'
+		continueStmt := NewContinueStatement()
+		continueStmt.Append(NewTokenNode(parser.nextToken()))
+		flowStmt.SetChild(continueStmt)
+	case "return":
+		returnStmt := parser.parseReturnStatement()
+		if returnStmt == nil {
+			return nil
+		}
+		flowStmt.SetChild(returnStmt)
+	case "raise":
+		raiseStmt := parser.parseRaiseStatement()
+		if raiseStmt == nil {
+			return nil
+		}
+		flowStmt.SetChild(raiseStmt)
+	case "yield":
+		// yield_stmt: yield_expr
+		yieldExpr := parser.parseYieldExpression()
+		if yieldExpr == nil {
+			return nil
+		}
+		yieldStmt := NewYieldStatement()
+		yieldStmt.SetChild(yieldExpr)
+		flowStmt.SetChild(yieldStmt)
+	}
+	return flowStmt
+}
+
+// return_stmt: 'return' [testlist]
+func (parser *GrammarParser) parseReturnStatement() *ReturnStatement {
+	returnStmt := NewReturnStatement()
+	returnStmt.Append(NewTokenNode(parser.nextToken()))
+	if parser.startsTest() {
+		testlist := parser.parseTestlist()
+		if testlist == nil {
+			return nil
+		}
+		returnStmt.Append(testlist)
+	}
+	return returnStmt
+}
+
+// raise_stmt: 'raise' [test ['from' test]]
+func (parser *GrammarParser) parseRaiseStatement() *RaiseStatement {
+	raiseStmt := NewRaiseStatement()
+	raiseStmt.Append(NewTokenNode(parser.nextToken()))
+	if !parser.startsTest() {
+		return raiseStmt
+	}
+
+	test := parser.parseTest()
+	if test == nil {
+		return nil
+	}
+	raiseStmt.Append(test)
+	if parser.peekLiteral("from") {
+		raiseStmt.Append(NewTokenNode(parser.nextToken()))
+		test = parser.parseTest()
+		if test == nil {
+			return nil
+		}
+		raiseStmt.Append(test)
+	}
+	return raiseStmt
+}
+
+// import_stmt: import_name | import_from
+func (parser *GrammarParser) parseImportStatement() *ImportStatement {
+	importStmt := NewImportStatement()
+	if parser.peekLiteral("import") {
+		importName := parser.parseImportName()
+		if importName == nil {
+			return nil
+		}
+		importStmt.SetChild(importName)
+	} else {
+		importFrom := parser.parseImportFrom()
+		if importFrom == nil {
+			return nil
+		}
+		importStmt.SetChild(importFrom)
+	}
+	return importStmt
+}
+
+// import_name: 'import' dotted_as_names
+func (parser *GrammarParser) parseImportName() *ImportName {
+	importName := NewImportName()
+	importName.Append(NewTokenNode(parser.nextToken()))
+	names := parser.parseDottedAsNames()
+	if names == nil {
+		return nil
+	}
+	importName.Append(names)
+	return importName
+}
+
+// import_from: ('from' (('.' | '...')* dotted_name | ('.' | '...')+)
+//               'import' ('*' | '(' import_as_names ')' | import_as_names))
+func (parser *GrammarParser) parseImportFrom() *ImportFrom {
+	importFrom := NewImportFrom()
+	importFrom.Append(NewTokenNode(parser.nextToken()))
+
+	dots := 0
+	for {
+		next := parser.peekToken()
+		if next.ID != token.DOT && next.ID != token.ELLIPSIS {
+			break
+		}
+		importFrom.Append(NewTokenNode(parser.nextToken()))
+		dots++
+	}
+	if dots == 0 || !parser.peekLiteral("import") {
+		name := parser.parseDottedName()
+		if name == nil {
+			return nil
+		}
+		importFrom.Append(name)
+	}
+
+	imp := parser.expectLiteral("import")
+	if imp == nil {
+		return nil
+	}
+	importFrom.Append(imp)
+
+	switch parser.peekToken().ID {
+	case token.STAR:
+		importFrom.Append(NewTokenNode(parser.nextToken()))
+	case token.LPAR:
+		importFrom.Append(NewTokenNode(parser.nextToken()))
+		names := parser.parseImportAsNames()
+		if names == nil {
+			return nil
+		}
+		importFrom.Append(names)
+		rpar := parser.expect(token.RPAR)
+		if rpar == nil {
+			return nil
+		}
+		importFrom.Append(rpar)
+	default:
+		names := parser.parseImportAsNames()
+		if names == nil {
+			return nil
+		}
+		importFrom.Append(names)
+	}
+	return importFrom
+}
+
+// import_as_name: NAME ['as' NAME]
+func (parser *GrammarParser) parseImportAsName() *ImportAsName {
+	importAsName := NewImportAsName()
+	name := parser.expectName()
+	if name == nil {
+		return nil
+	}
+	importAsName.Append(name)
+
+	if parser.peekLiteral("as") {
+		importAsName.Append(NewTokenNode(parser.nextToken()))
+		name = parser.expectName()
+		if name == nil {
+			return nil
+		}
+		importAsName.Append(name)
+	}
+	return importAsName
+}
+
+// dotted_as_name: dotted_name ['as' NAME]
+func (parser *GrammarParser) parseDottedAsName() *DottedAsName {
+	dottedAsName := NewDottedAsName()
+	dottedName := parser.parseDottedName()
+	if dottedName == nil {
+		return nil
+	}
+	dottedAsName.Append(dottedName)
+
+	if parser.peekLiteral("as") {
+		dottedAsName.Append(NewTokenNode(parser.nextToken()))
+		name := parser.expectName()
+		if name == nil {
+			return nil
+		}
+		dottedAsName.Append(name)
+	}
+	return dottedAsName
+}
+
+// import_as_names: import_as_name (',' import_as_name)* [',']
+func (parser *GrammarParser) parseImportAsNames() *ImportAsNames {
+	names := NewImportAsNames()
+	for {
+		name := parser.parseImportAsName()
+		if name == nil {
+			return nil
+		}
+		names.Append(name)
+
+		if parser.peekToken().ID != token.COMMA {
+			break
+		}
+		names.Append(NewTokenNode(parser.nextToken()))
+		if parser.peekToken().ID != token.NAME {
+			break
+		}
+	}
+	return names
+}
+
+// dotted_as_names: dotted_as_name (',' dotted_as_name)*
+func (parser *GrammarParser) parseDottedAsNames() *DottedAsNames {
+	names := NewDottedAsNames()
+	for {
+		name := parser.parseDottedAsName()
+		if name == nil {
+			return nil
+		}
+		names.Append(name)
+
+		if parser.peekToken().ID != token.COMMA {
+			break
+		}
+		names.Append(NewTokenNode(parser.nextToken()))
+	}
+	return names
+}
+
+// dotted_name: NAME ('.' NAME)*
+func (parser *GrammarParser) parseDottedName() *DottedName {
+	dottedName := NewDottedName()
+	for {
+		name := parser.expectName()
+		if name == nil {
+			return nil
+		}
+		dottedName.Append(name)
+
+		if parser.peekToken().ID != token.DOT {
+			break
+		}
+		dottedName.Append(NewTokenNode(parser.nextToken()))
+	}
+	return dottedName
+}
+
+// global_stmt: 'global' NAME (',' NAME)*
+func (parser *GrammarParser) parseGlobalStatement() *GlobalStatement {
+	globalStmt := NewGlobalStatement()
+	globalStmt.Append(NewTokenNode(parser.nextToken()))
+	for {
+		name := parser.expectName()
+		if name == nil {
+			return nil
+		}
+		globalStmt.Append(name)
+
+		if parser.peekToken().ID != token.COMMA {
+			break
+		}
+		globalStmt.Append(NewTokenNode(parser.nextToken()))
+	}
+	return globalStmt
+}
+
+// nonlocal_stmt: 'nonlocal' NAME (',' NAME)*
+func (parser *GrammarParser) parseNonlocalStatement() *NonlocalStatement {
+	nonlocalStmt := NewNonlocalStatement()
+	nonlocalStmt.Append(NewTokenNode(parser.nextToken()))
+	for {
+		name := parser.expectName()
+		if name == nil {
+			return nil
+		}
+		nonlocalStmt.Append(name)
+
+		if parser.peekToken().ID != token.COMMA {
+			break
+		}
+		nonlocalStmt.Append(NewTokenNode(parser.nextToken()))
+	}
+	return nonlocalStmt
+}
+
+// assert_stmt: 'assert' test [',' test]
+func (parser *GrammarParser) parseAssertStatement() *AssertStatement {
+	assertStmt := NewAssertStatement()
+	assertStmt.Append(NewTokenNode(parser.nextToken()))
+	test := parser.parseTest()
+	if test == nil {
+		return nil
+	}
+	assertStmt.Append(test)
+
+	if parser.peekToken().ID == token.COMMA {
+		assertStmt.Append(NewTokenNode(parser.nextToken()))
+		test = parser.parseTest()
+		if test == nil {
+			return nil
+		}
+		assertStmt.Append(test)
+	}
+	return assertStmt
+}
+
+// compound_stmt: if_stmt | while_stmt | for_stmt | try_stmt | with_stmt | funcdef | classdef | decorated | async_stmt
+func (parser *GrammarParser) parseCompoundStatement() *CompoundStatement {
+	compoundStmt := NewCompoundStatement()
+
+	var stmt CompoundStatementChild
+	next := parser.peekToken()
+	switch {
+	case next.ID == token.AT:
+		stmt = parser.parseDecorated()
+	case next.ID == token.ASYNC:
+		stmt = parser.parseAsyncStatement()
+	case next.IsLiteral("if"):
+		stmt = parser.parseIfStatement()
+	case next.IsLiteral("while"):
+		stmt = parser.parseWhileStatement()
+	case next.IsLiteral("for"):
+		stmt = parser.parseForStatement()
+	case next.IsLiteral("try"):
+		stmt = parser.parseTryStatement()
+	case next.IsLiteral("with"):
+		stmt = parser.parseWithStatement()
+	case next.IsLiteral("def"):
+		stmt = parser.parseFunctionDefinition()
+	case next.IsLiteral("class"):
+		stmt = parser.parseClassDefinition()
+	default:
+		parser.syntaxError(next)
+	}
+
+	if len(parser.Errors) > 0 {
+		return nil
+	}
+	compoundStmt.SetChild(stmt)
+	return compoundStmt
+}
+
+// async_stmt: ASYNC (funcdef | with_stmt | for_stmt)
+func (parser *GrammarParser) parseAsyncStatement() *AsyncStatement {
+	asyncStmt := NewAsyncStatement()
+	asyncStmt.Append(NewTokenNode(parser.nextToken()))
+
+	next := parser.peekToken()
+	switch {
+	case next.IsLiteral("def"):
+		funcDef := parser.parseFunctionDefinition()
+		if funcDef == nil {
+			return nil
+		}
+		asyncStmt.Append(funcDef)
+	case next.IsLiteral("with"):
+		withStmt := parser.parseWithStatement()
+		if withStmt == nil {
+			return nil
+		}
+		asyncStmt.Append(withStmt)
+	case next.IsLiteral("for"):
+		forStmt := parser.parseForStatement()
+		if forStmt == nil {
+			return nil
+		}
+		asyncStmt.Append(forStmt)
+	default:
+		parser.syntaxError(next)
+		return nil
+	}
+	return asyncStmt
+}
+
+// parseClause appends the ':' suite which ends a clause of a compound statement
+func (parser *GrammarParser) parseClause(appendChild func(Node)) bool {
+	colon := parser.expect(token.COLON)
+	if colon == nil {
+		return false
+	}
+	appendChild(colon)
+
+	suite := parser.parseSuite()
+	if suite == nil {
+		return false
+	}
+	appendChild(suite)
+	return true
+}
+
+// if_stmt: 'if' test ':' suite ('elif' test ':' suite)* ['else' ':' suite]
+func (parser *GrammarParser) parseIfStatement() *IfStatement {
+	ifStmt := NewIfStatement()
+	appendChild := func(n Node) { ifStmt.Append(n.(IfStatementChild)) }
+	for {
+		ifStmt.Append(NewTokenNode(parser.nextToken()))
+		test := parser.parseTest()
+		if test == nil {
+			return nil
+		}
+		ifStmt.Append(test)
+		if !parser.parseClause(appendChild) {
+			return nil
+		}
+		if !parser.peekLiteral("elif") {
+			break
+		}
+	}
+
+	if parser.peekLiteral("else") {
+		ifStmt.Append(NewTokenNode(parser.nextToken()))
+		if !parser.parseClause(appendChild) {
+			return nil
+		}
+	}
+	return ifStmt
+}
+
+// while_stmt: 'while' test ':' suite ['else' ':' suite]
+func (parser *GrammarParser) parseWhileStatement() *WhileStatement {
+	whileStmt := NewWhileStatement()
+	appendChild := func(n Node) { whileStmt.Append(n.(WhileStatementChild)) }
+	whileStmt.Append(NewTokenNode(parser.nextToken()))
+	test := parser.parseTest()
+	if test == nil {
+		return nil
+	}
+	whileStmt.Append(test)
+	if !parser.parseClause(appendChild) {
+		return nil
+	}
+
+	if parser.peekLiteral("else") {
+		whileStmt.Append(NewTokenNode(parser.nextToken()))
+		if !parser.parseClause(appendChild) {
+			return nil
+		}
+	}
+	return whileStmt
+}
+
+// for_stmt: 'for' exprlist 'in' testlist ':' suite ['else' ':' suite]
+func (parser *GrammarParser) parseForStatement() *ForStatement {
+	forStmt := NewForStatement()
+	appendChild := func(n Node) { forStmt.Append(n.(ForStatementChild)) }
+	forStmt.Append(NewTokenNode(parser.nextToken()))
+	exprList := parser.parseExpressionList()
+	if exprList == nil {
+		return nil
+	}
+	forStmt.Append(exprList)
+
+	in := parser.expectLiteral("in")
+	if in == nil {
+		return nil
+	}
+	forStmt.Append(in)
+
+	testlist := parser.parseTestlist()
+	if testlist == nil {
+		return nil
+	}
+	forStmt.Append(testlist)
+	if !parser.parseClause(appendChild) {
+		return nil
+	}
+
+	if parser.peekLiteral("else") {
+		forStmt.Append(NewTokenNode(parser.nextToken()))
+		if !parser.parseClause(appendChild) {
+			return nil
+		}
+	}
+	return forStmt
+}
+
+// try_stmt: ('try' ':' suite
+//            ((except_clause ':' suite)+
+//             ['else' ':' suite]
+//             ['finally' ':' suite] |
+//            'finally' ':' suite))
+func (parser *GrammarParser) parseTryStatement() *TryStatement {
+	tryStmt := NewTryStatement()
+	appendChild := func(n Node) { tryStmt.Append(n.(TryStatementChild)) }
+	tryStmt.Append(NewTokenNode(parser.nextToken()))
+	if !parser.parseClause(appendChild) {
+		return nil
+	}
+
+	if parser.peekLiteral("except") {
+		for parser.peekLiteral("except") {
+			exceptClause := parser.parseExceptClause()
+			if exceptClause == nil {
+				return nil
+			}
+			tryStmt.Append(exceptClause)
+			if !parser.parseClause(appendChild) {
+				return nil
+			}
+		}
+		if parser.peekLiteral("else") {
+			tryStmt.Append(NewTokenNode(parser.nextToken()))
+			if !parser.parseClause(appendChild) {
+				return nil
+			}
+		}
+		if !parser.peekLiteral("finally") {
+			return tryStmt
+		}
+	}
+
+	finally := parser.expectLiteral("finally")
+	if finally == nil {
+		return nil
+	}
+	tryStmt.Append(finally)
+	if !parser.parseClause(appendChild) {
+		return nil
+	}
+	return tryStmt
+}
+
+// with_stmt: 'with' with_item (',' with_item)*  ':' suite
+func (parser *GrammarParser) parseWithStatement() *WithStatement {
+	withStmt := NewWithStatement()
+	appendChild := func(n Node) { withStmt.Append(n.(WithStatementChild)) }
+	withStmt.Append(NewTokenNode(parser.nextToken()))
+	for {
+		item := parser.parseWithItem()
+		if item == nil {
+			return nil
+		}
+		withStmt.Append(item)
+
+		if parser.peekToken().ID != token.COMMA {
+			break
+		}
+		withStmt.Append(NewTokenNode(parser.nextToken()))
+	}
+
+	if !parser.parseClause(appendChild) {
+		return nil
+	}
+	return withStmt
+}
+
+// with_item: test ['as' expr]
+func (parser *GrammarParser) parseWithItem() *WithItem {
+	item := NewWithItem()
+	test := parser.parseTest()
+	if test == nil {
+		return nil
+	}
+	item.Append(test)
+
+	if parser.peekLiteral("as") {
+		item.Append(NewTokenNode(parser.nextToken()))
+		expr := parser.parseExpression()
+		if expr == nil {
+			return nil
+		}
+		item.Append(expr)
+	}
+	return item
+}
+
+// except_clause: 'except' [test ['as' NAME]]
+func (parser *GrammarParser) parseExceptClause() *ExceptClause {
+	exceptClause := NewExceptClause()
+	exceptClause.Append(NewTokenNode(parser.nextToken()))
+	if !parser.startsTest() {
+		return exceptClause
+	}
+
+	test := parser.parseTest()
+	if test == nil {
+		return nil
+	}
+	exceptClause.Append(test)
+
+	if parser.peekLiteral("as") {
+		exceptClause.Append(NewTokenNode(parser.nextToken()))
+		name := parser.expectName()
+		if name == nil {
+			return nil
+		}
+		exceptClause.Append(name)
+	}
+	return exceptClause
+}
+
+// suite: simple_stmt | NEWLINE INDENT stmt+ DEDENT
+func (parser *GrammarParser) parseSuite() *Suite {
+	suite := NewSuite()
+	if parser.peekToken().ID != token.NEWLINE {
+		simpleStmt := parser.parseSimpleStatement()
+		if simpleStmt == nil {
+			return nil
+		}
+		suite.Append(simpleStmt)
+		return suite
+	}
+
+	suite.Append(NewTokenNode(parser.nextToken()))
+	indent := parser.expect(token.INDENT)
+	if indent == nil {
+		return nil
+	}
+	suite.Append(indent)
+
+	for {
+		stmt := parser.parseStatement()
+		if stmt == nil {
+			return nil
+		}
+		suite.Append(stmt)
+		if parser.peekToken().ID == token.DEDENT {
+			break
+		}
+	}
+	suite.Append(NewTokenNode(parser.nextToken()))
+	return suite
+}
+
+// test: or_test ['if' or_test 'else' test] | lambdef
+func (parser *GrammarParser) parseTest() *Test {
+	if !parser.enter() {
+		return nil
+	}
+	defer parser.leave()
+
+	test := NewTest()
+	if parser.peekLiteral("lambda") {
+		lambdef := parser.parseLambdaDefinition()
+		if lambdef == nil {
+			return nil
+		}
+		test.Append(lambdef)
+		return test
+	}
+
+	orTest := parser.parseOrTest()
+	if orTest == nil {
+		return nil
+	}
+	test.Append(orTest)
+
+	// Do not use `parser.expectLiteral`, this next part is optional
+	if !parser.peekLiteral("if") {
+		return test
+	}
+	test.Append(NewTokenNode(parser.nextToken()))
+	orTest = parser.parseOrTest()
+	if orTest == nil {
+		return nil
+	}
+	test.Append(orTest)
+
+	elseToken := parser.expectLiteral("else")
+	if elseToken == nil {
+		return nil
+	}
+	test.Append(elseToken)
+
+	elseTest := parser.parseTest()
+	if elseTest == nil {
+		return nil
+	}
+	test.Append(elseTest)
+	return test
+}
+
+// test_nocond: or_test | lambdef_nocond
+func (parser *GrammarParser) parseTestNocond() *TestNocond {
+	if !parser.enter() {
+		return nil
+	}
+	defer parser.leave()
+
+	test := NewTestNocond()
+	if parser.peekLiteral("lambda") {
+		lambdef := parser.parseLambdaDefinitionNocond()
+		if lambdef == nil {
+			return nil
+		}
+		test.SetChild(lambdef)
+		return test
+	}
+
+	orTest := parser.parseOrTest()
+	if orTest == nil {
+		return nil
+	}
+	test.SetChild(orTest)
+	return test
+}
+
+// lambdef: 'lambda' [varargslist] ':' test
+func (parser *GrammarParser) parseLambdaDefinition() *LambdaDefinition {
+	lambdef := NewLambdaDefinition()
+	lambdef.Append(NewTokenNode(parser.nextToken()))
+	if parser.peekToken().ID != token.COLON {
+		args := parser.parseVarArgsList()
+		if args == nil {
+			return nil
+		}
+		lambdef.Append(args)
+	}
+
+	colon := parser.expect(token.COLON)
+	if colon == nil {
+		return nil
+	}
+	lambdef.Append(colon)
+
+	test := parser.parseTest()
+	if test == nil {
+		return nil
+	}
+	lambdef.Append(test)
+	return lambdef
+}
+
+// lambdef_nocond: 'lambda' [varargslist] ':' test_nocond
+func (parser *GrammarParser) parseLambdaDefinitionNocond() *LambdaDefinitionNocond {
+	lambdef := NewLambdaDefinitionNocond()
+	lambdef.Append(NewTokenNode(parser.nextToken()))
+	if parser.peekToken().ID != token.COLON {
+		args := parser.parseVarArgsList()
+		if args == nil {
+			return nil
+		}
+		lambdef.Append(args)
+	}
+
+	colon := parser.expect(token.COLON)
+	if colon == nil {
+		return nil
+	}
+	lambdef.Append(colon)
+
+	test := parser.parseTestNocond()
+	if test == nil {
+		return nil
+	}
+	lambdef.Append(test)
+	return lambdef
+}
+
+// or_test: and_test ('or' and_test)*
+func (parser *GrammarParser) parseOrTest() *OrTest {
+	orTest := NewOrTest()
+	andTest := parser.parseAndTest()
+	if andTest == nil {
+		return nil
+	}
+	orTest.Append(andTest)
+	for parser.peekLiteral("or") {
+		orTest.Append(NewTokenNode(parser.nextToken()))
+		andTest = parser.parseAndTest()
+		if andTest == nil {
+			return nil
+		}
+		orTest.Append(andTest)
+	}
+	return orTest
+}
+
+// and_test: not_test ('and' not_test)*
+func (parser *GrammarParser) parseAndTest() *AndTest {
+	andTest := NewAndTest()
+	notTest := parser.parseNotTest()
+	if notTest == nil {
+		return nil
+	}
+	andTest.Append(notTest)
+	for parser.peekLiteral("and") {
+		andTest.Append(NewTokenNode(parser.nextToken()))
+		notTest = parser.parseNotTest()
+		if notTest == nil {
+			return nil
+		}
+		andTest.Append(notTest)
+	}
+	return andTest
+}
+
+// not_test: 'not' not_test | comparison
+func (parser *GrammarParser) parseNotTest() *NotTest {
+	if !parser.enter() {
+		return nil
+	}
+	defer parser.leave()
+
+	notTest := NewNotTest()
+	if parser.peekLiteral("not") {
+		notTest.Append(NewTokenNode(parser.nextToken()))
+		test := parser.parseNotTest()
+		if test == nil {
+			return nil
+		}
+		notTest.Append(test)
+	} else {
+		comparison := parser.parseComparison()
+		if comparison == nil {
+			return nil
+		}
+		notTest.Append(comparison)
+	}
+	return notTest
+}
+
+// comparison: expr (comp_op expr)*
+func (parser *GrammarParser) parseComparison() *Comparison {
+	comparison := NewComparison()
+	expr := parser.parseExpression()
+	if expr == nil {
+		return nil
+	}
+	comparison.Append(expr)
+
+	for {
+		compOp := parser.parseCompareOperator()
+		if compOp == nil {
+			break
+		}
+		comparison.Append(compOp)
+
+		expr := parser.parseExpression()
+		if expr == nil {
+			return nil
+		}
+		comparison.Append(expr)
+	}
+
+	return comparison
+}
+
+// comp_op: '<'|'>'|'=='|'>='|'<='|'<>'|'!='|'in'|'not' 'in'|'is'|'is' 'not'
+//
+// parseCompareOperator returns nil without an error when the next token is not a comp_op.
+// Like CPython without barry_as_FLUFL, '<>' is not accepted.
+func (parser *GrammarParser) parseCompareOperator() *CompareOperator {
+	compOp := NewCompareOperator()
+	next := parser.peekToken()
+	switch {
+	case next.ID == token.LESS, next.ID == token.GREATER, next.ID == token.EQEQUAL,
+		next.ID == token.GREATEREQUAL, next.ID == token.LESSEQUAL, next.IsLiteral("in"):
+		compOp.Append(NewTokenNode(parser.nextToken()))
+	case next.ID == token.NOTEQUAL && next.Literal == "!=":
+		compOp.Append(NewTokenNode(parser.nextToken()))
+	case next.IsLiteral("is"):
+		compOp.Append(NewTokenNode(parser.nextToken()))
+		if parser.peekLiteral("not") {
+			compOp.Append(NewTokenNode(parser.nextToken()))
+		}
+	case next.IsLiteral("not") && parser.tokens.Peek(1).IsLiteral("in"):
+		compOp.Append(NewTokenNode(parser.nextToken()))
+		compOp.Append(NewTokenNode(parser.nextToken()))
+	default:
+		return nil
+	}
+	return compOp
+}
+
+// star_expr: '*' expr
+func (parser *GrammarParser) parseStarExpression() *StarExpression {
+	starExpr := NewStarExpression()
+	star := parser.expect(token.STAR)
+	if star == nil {
+		return nil
+	}
+	starExpr.Append(star)
+
+	expr := parser.parseExpression()
+	if expr == nil {
+		return nil
+	}
+	starExpr.Append(expr)
+	return starExpr
+}
+
+// expr: xor_expr ('|' xor_expr)*
+func (parser *GrammarParser) parseExpression() *Expression {
+	expr := NewExpression()
+	xorExpr := parser.parseXorExpression()
+	if xorExpr == nil {
+		return nil
+	}
+	expr.Append(xorExpr)
+	for parser.peekToken().ID == token.VBAR {
+		expr.Append(NewTokenNode(parser.nextToken()))
+		xorExpr := parser.parseXorExpression()
+		if xorExpr == nil {
+			return nil
+		}
+		expr.Append(xorExpr)
+	}
+	return expr
+}
+
+// xor_expr: and_expr ('^' and_expr)*
+func (parser *GrammarParser) parseXorExpression() *XorExpression {
+	expr := NewXorExpression()
+	andExpr := parser.parseAndExpression()
+	if andExpr == nil {
+		return nil
+	}
+	expr.Append(andExpr)
+	for parser.peekToken().ID == token.CIRCUMFLEX {
+		expr.Append(NewTokenNode(parser.nextToken()))
+		andExpr := parser.parseAndExpression()
+		if andExpr == nil {
+			return nil
+		}
+		expr.Append(andExpr)
+	}
+	return expr
+}
+
+// and_expr: shift_expr ('&' shift_expr)*
+func (parser *GrammarParser) parseAndExpression() *AndExpression {
+	expr := NewAndExpression()
+	shiftExpr := parser.parseShiftExpression()
+	if shiftExpr == nil {
+		return nil
+	}
+	expr.Append(shiftExpr)
+	for parser.peekToken().ID == token.AMPER {
+		expr.Append(NewTokenNode(parser.nextToken()))
+		shiftExpr := parser.parseShiftExpression()
+		if shiftExpr == nil {
+			return nil
+		}
+		expr.Append(shiftExpr)
+	}
+	return expr
+}
+
+// shift_expr: arith_expr (('<<'|'>>') arith_expr)*
+func (parser *GrammarParser) parseShiftExpression() *ShiftExpression {
+	expr := NewShiftExpression()
+	arithExpr := parser.parseArithmetricExpression()
+	if arithExpr == nil {
+		return nil
+	}
+	expr.Append(arithExpr)
+	for {
+		next := parser.peekToken()
+		if next.ID != token.LEFTSHIFT && next.ID != token.RIGHTSHIFT {
+			break
+		}
+		expr.Append(NewTokenNode(parser.nextToken()))
+		arithExpr := parser.parseArithmetricExpression()
+		if arithExpr == nil {
+			return nil
+		}
+		expr.Append(arithExpr)
+	}
+	return expr
+}
+
+// arith_expr: term (('+'|'-') term)*
+func (parser *GrammarParser) parseArithmetricExpression() *ArithmeticExpression {
+	expr := NewArithmeticExpression()
+	term := parser.parseTerm()
+	if term == nil {
+		return nil
+	}
+	expr.Append(term)
+	for {
+		next := parser.peekToken()
+		if next.ID != token.PLUS && next.ID != token.MINUS {
+			break
+		}
+		expr.Append(NewTokenNode(parser.nextToken()))
+		term := parser.parseTerm()
+		if term == nil {
+			return nil
+		}
+		expr.Append(term)
+	}
+	return expr
+}
+
+// term: factor (('*'|'@'|'/'|'%'|'//') factor)*
+func (parser *GrammarParser) parseTerm() *Term {
+	term := NewTerm()
+	factor := parser.parseFactor()
+	if factor == nil {
+		return nil
+	}
+	term.Append(factor)
+	for {
+		next := parser.peekToken()
+		if next.ID != token.STAR && next.ID != token.AT && next.ID != token.SLASH && next.ID != token.PERCENT && next.ID != token.DOUBLESLASH {
+			break
+		}
+		term.Append(NewTokenNode(parser.nextToken()))
+		factor := parser.parseFactor()
+		if factor == nil {
+			return nil
+		}
+		term.Append(factor)
+	}
+	return term
+}
+
+// factor: ('+'|'-'|'~') factor | power
+func (parser *GrammarParser) parseFactor() *Factor {
+	if !parser.enter() {
+		return nil
+	}
+	defer parser.leave()
+
+	factor := NewFactor()
+	next := parser.peekToken()
+	switch next.ID {
+	case token.PLUS, token.MINUS, token.TILDE:
+		parser.nextToken()
+		node := parser.parseFactor()
+		if node == nil {
+			return nil
+		}
+		factor.Append(NewTokenNode(next))
+		factor.Append(node)
+	default:
+		power := parser.parsePower()
+		if power == nil {
+			return nil
+		}
+		factor.Append(power)
+	}
+
+	return factor
+}
+
+// power: atom_expr ['**' factor]
+func (parser *GrammarParser) parsePower() *Power {
+	power := NewPower()
+	atomExpr := parser.parseAtomExpression()
+	if atomExpr == nil {
+		return nil
+	}
+	power.Append(atomExpr)
+
+	if next := parser.peekToken(); next.ID == token.DOUBLESTAR {
+		power.Append(NewTokenNode(parser.nextToken()))
+		factor := parser.parseFactor()
+		if factor == nil {
+			return nil
+		}
+		power.Append(factor)
+	}
+	return power
+}
+
+// atom_expr: [AWAIT] atom trailer*
+func (parser *GrammarParser) parseAtomExpression() *AtomExpression {
+	expr := NewAtomExpression()
+	if next := parser.peekToken(); next.ID == token.AWAIT {
+		expr.Append(NewTokenNode(parser.nextToken()))
+	}
+
+	atom := parser.parseAtom()
+	if atom == nil {
+		return nil
+	}
+	expr.Append(atom)
+	for {
+		next := parser.peekToken()
+		if next.ID != token.LPAR && next.ID != token.LSQB && next.ID != token.DOT {
+			break
+		}
+		trailer := parser.parseTrailer()
+		if trailer == nil {
+			return nil
+		}
+		expr.Append(trailer)
+	}
+
+	return expr
+}
+
+// atom: ('(' [yield_expr|testlist_comp] ')' |
+//        '[' [testlist_comp] ']' |
+//        '{' [dictorsetmaker] '}' |
+//        NAME | NUMBER | STRING+ | '...' | 'None' | 'True' | 'False')
+func (parser *GrammarParser) parseAtom() *Atom {
+	atom := NewAtom()
+	next := parser.peekToken()
+	switch next.ID {
+	case token.NAME:
+		if !parser.startsExpression() {
+			parser.syntaxError(next)
+			return nil
+		}
+		atom.Append(NewTokenNode(parser.nextToken()))
+	case token.NUMBER, token.ELLIPSIS:
+		atom.Append(NewTokenNode(parser.nextToken()))
+	case token.STRING:
+		for parser.peekToken().ID == token.STRING {
+			atom.Append(NewTokenNode(parser.nextToken()))
+		}
+	case token.LPAR:
+		atom.Append(NewTokenNode(parser.nextToken()))
+		if parser.peekLiteral("yield") {
+			yieldExpr := parser.parseYieldExpression()
+			if yieldExpr == nil {
+				return nil
+			}
+			atom.Append(yieldExpr)
+		} else if parser.peekToken().ID != token.RPAR {
+			testlistComp := parser.parseTestlistComprehension()
+			if testlistComp == nil {
+				return nil
+			}
+			atom.Append(testlistComp)
+		}
+		rpar := parser.expect(token.RPAR)
+		if rpar == nil {
+			return nil
+		}
+		atom.Append(rpar)
+	case token.LSQB:
+		atom.Append(NewTokenNode(parser.nextToken()))
+		if parser.peekToken().ID != token.RSQB {
+			testlistComp := parser.parseTestlistComprehension()
+			if testlistComp == nil {
+				return nil
+			}
+			atom.Append(testlistComp)
+		}
+		rsqb := parser.expect(token.RSQB)
+		if rsqb == nil {
+			return nil
+		}
+		atom.Append(rsqb)
+	case token.LBRACE:
+		atom.Append(NewTokenNode(parser.nextToken()))
+		if parser.peekToken().ID != token.RBRACE {
+			maker := parser.parseDictOrSetMaker()
+			if maker == nil {
+				return nil
+			}
+			atom.Append(maker)
+		}
+		rbrace := parser.expect(token.RBRACE)
+		if rbrace == nil {
+			return nil
+		}
+		atom.Append(rbrace)
+	default:
+		parser.syntaxError(next)
+		return nil
+	}
+	return atom
+}
+
+// testlist_comp: (test|star_expr) ( comp_for | (',' (test|star_expr))* [','] )
+func (parser *GrammarParser) parseTestlistComprehension() *TestlistComprehension {
+	testlistComp := NewTestlistComprehension()
+	for {
+		if parser.peekToken().ID == token.STAR {
+			starExpr := parser.parseStarExpression()
+			if starExpr == nil {
+				return nil
+			}
+			testlistComp.Append(starExpr)
+		} else {
+			test := parser.parseTest()
+			if test == nil {
+				return nil
+			}
+			testlistComp.Append(test)
+		}
+
+		if testlistComp.Length() == 1 && parser.peekLiteral("for") {
+			compFor := parser.parseComprehensionFor()
+			if compFor == nil {
+				return nil
+			}
+			testlistComp.Append(compFor)
+			break
+		}
+		if parser.peekToken().ID != token.COMMA {
+			break
+		}
+		testlistComp.Append(NewTokenNode(parser.nextToken()))
+		if !parser.startsTest() && parser.peekToken().ID != token.STAR {
+			break
+		}
+	}
+	return testlistComp
+}
+
+// trailer: '(' [arglist] ')' | '[' subscriptlist ']' | '.' NAME
+func (parser *GrammarParser) parseTrailer() *Trailer {
+	trailer := NewTrailer()
+	next := parser.nextToken()
+	trailer.Append(NewTokenNode(next))
+	switch next.ID {
+	case token.LPAR:
+		if parser.peekToken().ID != token.RPAR {
+			args := parser.parseArgumentList()
+			if args == nil {
+				return nil
+			}
+			trailer.Append(args)
+		}
+		rpar := parser.expect(token.RPAR)
+		if rpar == nil {
+			return nil
+		}
+		trailer.Append(rpar)
+	case token.LSQB:
+		subscripts := parser.parseSubscriptList()
+		if subscripts == nil {
+			return nil
+		}
+		trailer.Append(subscripts)
+		rsqb := parser.expect(token.RSQB)
+		if rsqb == nil {
 			return nil
 		}
-		expr.Append(term)
+		trailer.Append(rsqb)
+	case token.DOT:
+		name := parser.expectName()
+		if name == nil {
+			return nil
+		}
+		trailer.Append(name)
 	}
-	return expr
+	return trailer
 }
 
-// shift_expr: arith_expr (('<<'|'>>') arith_expr)*
-func (parser *GrammarParser) parseShiftExpression() *ShiftExpression {
-	expr := NewShiftExpression()
-	arithExpr := parser.parseArithmetricExpression()
-	if arithExpr == nil {
-		return nil
-	}
-	expr.Append(arithExpr)
+// subscriptlist: subscript (',' subscript)* [',']
+func (parser *GrammarParser) parseSubscriptList() *SubscriptList {
+	subscripts := NewSubscriptList()
 	for {
-		next := parser.nextToken()
-		if next.ID != token.LEFTSHIFT && next.ID != token.RIGHTSHIFT {
-			parser.unreadToken(next)
+		subscript := parser.parseSubscript()
+		if subscript == nil {
+			return nil
+		}
+		subscripts.Append(subscript)
+
+		if parser.peekToken().ID != token.COMMA {
 			break
 		}
-		expr.Append(NewTokenNode(next))
-		arithExpr := parser.parseArithmetricExpression()
-		if arithExpr == nil {
-			return nil
+		subscripts.Append(NewTokenNode(parser.nextToken()))
+		if !parser.startsTest() && parser.peekToken().ID != token.COLON {
+			break
 		}
-		expr.Append(arithExpr)
 	}
-	return expr
+	return subscripts
 }
 
-// and_expr: shift_expr ('&' shift_expr)*
-func (parser *GrammarParser) parseAndExpression() *AndExpression {
-	expr := NewAndExpression()
-	shiftExpr := parser.parseShiftExpression()
-	if shiftExpr == nil {
-		return nil
+// subscript: test | [test] ':' [test] [sliceop]
+func (parser *GrammarParser) parseSubscript() *Subscript {
+	subscript := NewSubscript()
+	if parser.peekToken().ID != token.COLON {
+		test := parser.parseTest()
+		if test == nil {
+			return nil
+		}
+		subscript.Append(test)
+		if parser.peekToken().ID != token.COLON {
+			return subscript
+		}
 	}
-	expr.Append(shiftExpr)
-	for {
-		next := parser.nextToken()
-		if next.ID != token.AMPER {
-			parser.unreadToken(next)
-			break
+
+	subscript.Append(NewTokenNode(parser.nextToken()))
+	if parser.startsTest() {
+		test := parser.parseTest()
+		if test == nil {
+			return nil
 		}
-		shiftExpr := parser.parseShiftExpression()
-		if shiftExpr == nil {
+		subscript.Append(test)
+	}
+	if parser.peekToken().ID == token.COLON {
+		sliceOp := parser.parseSliceOperation()
+		if sliceOp == nil {
 			return nil
 		}
-		expr.Append(shiftExpr)
+		subscript.Append(sliceOp)
 	}
-	return expr
+	return subscript
 }
 
-// xor_expr: and_expr ('^' and_expr)*
-func (parser *GrammarParser) parseXorExpression() *XorExpression {
-	expr := NewXorExpression()
-	andExpr := parser.parseAndExpression()
-	if andExpr == nil {
-		return nil
+// sliceop: ':' [test]
+func (parser *GrammarParser) parseSliceOperation() *SliceOperation {
+	sliceOp := NewSliceOperation()
+	sliceOp.Append(NewTokenNode(parser.nextToken()))
+	if parser.startsTest() {
+		test := parser.parseTest()
+		if test == nil {
+			return nil
+		}
+		sliceOp.Append(test)
 	}
-	expr.Append(andExpr)
+	return sliceOp
+}
+
+// exprlist: (expr|star_expr) (',' (expr|star_expr))* [',']
+func (parser *GrammarParser) parseExpressionList() *ExpressionList {
+	exprList := NewExpressionList()
 	for {
-		next := parser.nextToken()
-		if next.ID != token.CIRCUMFLEX {
-			parser.unreadToken(next)
+		if parser.peekToken().ID == token.STAR {
+			starExpr := parser.parseStarExpression()
+			if starExpr == nil {
+				return nil
+			}
+			exprList.Append(starExpr)
+		} else {
+			expr := parser.parseExpression()
+			if expr == nil {
+				return nil
+			}
+			exprList.Append(expr)
+		}
+
+		if parser.peekToken().ID != token.COMMA {
 			break
 		}
-		andExpr := parser.parseAndExpression()
-		if andExpr == nil {
-			return nil
+		exprList.Append(NewTokenNode(parser.nextToken()))
+		if !parser.startsExpression() && parser.peekToken().ID != token.STAR {
+			break
 		}
-		expr.Append(andExpr)
 	}
-	return expr
+	return exprList
 }
 
-// expr: xor_expr ('|' xor_expr)*
-func (parser *GrammarParser) parseExpression() *Expression {
-	expr := NewExpression()
-	xorExpr := parser.parseXorExpression()
-	if xorExpr == nil {
-		return nil
-	}
-	expr.Append(xorExpr)
+// testlist: test (',' test)* [',']
+func (parser *GrammarParser) parseTestlist() *Testlist {
+	testlist := NewTestlist()
 	for {
-		next := parser.nextToken()
-		if next.ID != token.VBAR {
-			parser.unreadToken(next)
+		test := parser.parseTest()
+		if test == nil {
+			return nil
+		}
+		testlist.Append(test)
+
+		if parser.peekToken().ID != token.COMMA {
 			break
 		}
-		xorExpr := parser.parseXorExpression()
-		if xorExpr == nil {
-			return nil
+		testlist.Append(NewTokenNode(parser.nextToken()))
+		if !parser.startsTest() {
+			break
 		}
-		expr.Append(xorExpr)
 	}
-	return expr
+	return testlist
 }
 
-// comparison: expr (comp_op expr)*
-func (parser *GrammarParser) parseComparison() *Comparison {
-	comparison := NewComparison()
-	expr := parser.parseExpression()
-	if expr == nil {
-		return nil
-	}
-	comparison.Append(expr)
-
-	for {
-		// comp_op: '<'|'>'|'=='|'>='|'<='|'<>'|'!='|'in'|'not' 'in'|'is'|'is' 'not'
-		compOp := true
-		next := parser.nextToken()
-		switch next.Literal {
-		case "<", ">", "==", ">=", "<=", "<>", "!=", "in":
-			comparison.Append(NewTokenNode(next))
-		case "is":
-			comparison.Append(NewTokenNode(next))
-			next2 := parser.nextToken()
-			if next2.Literal == "not" {
-				comparison.Append(NewTokenNode(next2))
-			} else {
-				parser.unreadToken(next2)
-			}
-		case "not":
-			next2 := parser.nextToken()
-			if next2.Literal == "in" {
-				comparison.Append(NewTokenNode(next))
-				comparison.Append(NewTokenNode(next2))
-			} else {
-				parser.unreadToken(next2)
-				parser.unreadToken(next)
-				compOp = false
+// dictorsetmaker: ( ((test ':' test | '**' expr)
+//                    (comp_for | (',' (test ':' test | '**' expr))* [','])) |
+//                   ((test | star_expr)
+//                    (comp_for | (',' (test | star_expr))* [','])) )
+func (parser *GrammarParser) parseDictOrSetMaker() *DictOrSetMaker {
+	maker := NewDictOrSetMaker()
+	isDict := false
+	for first := true; ; first = false {
+		next := parser.peekToken()
+		switch {
+		case next.ID == token.DOUBLESTAR && (first || isDict):
+			isDict = true
+			maker.Append(NewTokenNode(parser.nextToken()))
+			expr := parser.parseExpression()
+			if expr == nil {
+				return nil
+			}
+			maker.Append(expr)
+		case next.ID == token.STAR && !isDict:
+			starExpr := parser.parseStarExpression()
+			if starExpr == nil {
+				return nil
 			}
+			maker.Append(starExpr)
 		default:
-			parser.unreadToken(next)
-			compOp = false
+			test := parser.parseTest()
+			if test == nil {
+				return nil
+			}
+			maker.Append(test)
+			if first {
+				isDict = parser.peekToken().ID == token.COLON
+			}
+			if isDict {
+				colon := parser.expect(token.COLON)
+				if colon == nil {
+					return nil
+				}
+				maker.Append(colon)
+				test = parser.parseTest()
+				if test == nil {
+					return nil
+				}
+				maker.Append(test)
+			}
 		}
-		if compOp == false {
+
+		if first && parser.peekLiteral("for") {
+			compFor := parser.parseComprehensionFor()
+			if compFor == nil {
+				return nil
+			}
+			maker.Append(compFor)
 			break
 		}
-		expr := parser.parseExpression()
-		if expr == nil {
-			return nil
+		if parser.peekToken().ID != token.COMMA {
+			break
+		}
+		maker.Append(NewTokenNode(parser.nextToken()))
+		next = parser.peekToken()
+		if !parser.startsTest() && next.ID != token.STAR && next.ID != token.DOUBLESTAR {
+			break
 		}
-		comparison.Append(expr)
 	}
-
-	return comparison
+	return maker
 }
 
-// not_test: 'not' not_test | comparison
-func (parser *GrammarParser) parseNotTest() *NotTest {
-	notTest := NewNotTest()
-	next := parser.nextToken()
-	if next.IsLiteral("not") {
-		test := parser.parseNotTest()
-		if test == nil {
-			return nil
+// classdef: 'class' NAME ['(' [arglist] ')'] ':' suite
+func (parser *GrammarParser) parseClassDefinition() *ClassDefinition {
+	classDef := NewClassDefinition()
+	appendChild := func(n Node) { classDef.Append(n.(ClassDefinitionChild)) }
+	classDef.Append(NewTokenNode(parser.nextToken()))
+	name := parser.expectName()
+	if name == nil {
+		return nil
+	}
+	classDef.Append(name)
+
+	if parser.peekToken().ID == token.LPAR {
+		classDef.Append(NewTokenNode(parser.nextToken()))
+		if parser.peekToken().ID != token.RPAR {
+			args := parser.parseArgumentList()
+			if args == nil {
+				return nil
+			}
+			classDef.Append(args)
 		}
-		notTest.Append(test)
-	} else {
-		parser.unreadToken(next)
-		comparison := parser.parseComparison()
-		if comparison == nil {
+		rpar := parser.expect(token.RPAR)
+		if rpar == nil {
 			return nil
 		}
-		notTest.Append(comparison)
+		classDef.Append(rpar)
 	}
-	return notTest
-}
 
-// and_test: not_test ('and' not_test)*
-func (parser *GrammarParser) parseAndTest() *AndTest {
-	andTest := NewAndTest()
-	notTest := parser.parseNotTest()
-	if notTest == nil {
+	if !parser.parseClause(appendChild) {
 		return nil
 	}
-	andTest.Append(notTest)
+	return classDef
+}
+
+// arglist: argument (',' argument)*  [',']
+func (parser *GrammarParser) parseArgumentList() *ArgumentList {
+	args := NewArgumentList()
 	for {
-		next := parser.nextToken()
-		if !next.IsLiteral("and") {
-			parser.unreadToken(next)
+		arg := parser.parseArgument()
+		if arg == nil {
+			return nil
+		}
+		args.Append(arg)
+
+		if parser.peekToken().ID != token.COMMA {
 			break
 		}
-		notTest = parser.parseNotTest()
-		if notTest == nil {
-			return nil
+		args.Append(NewTokenNode(parser.nextToken()))
+		next := parser.peekToken()
+		if !parser.startsTest() && next.ID != token.STAR && next.ID != token.DOUBLESTAR {
+			break
 		}
-		andTest.Append(notTest)
 	}
-
-	return andTest
+	return args
 }
 
-// or_test: and_test ('or' and_test)*
-func (parser *GrammarParser) parseOrTest() *OrTest {
-	orTest := NewOrTest()
-	andTest := parser.parseAndTest()
-	if andTest == nil {
+// argument: ( test [comp_for] |
+//             test '=' test |
+//             '**' test |
+//             '*' test )
+func (parser *GrammarParser) parseArgument() *Argument {
+	arg := NewArgument()
+	next := parser.peekToken()
+	if next.ID == token.STAR || next.ID == token.DOUBLESTAR {
+		arg.Append(NewTokenNode(parser.nextToken()))
+	}
+
+	test := parser.parseTest()
+	if test == nil {
 		return nil
 	}
-	orTest.Append(andTest)
-	for {
-		next := parser.nextToken()
-		if !next.IsLiteral("and") {
-			parser.unreadToken(next)
-			break
+	arg.Append(test)
+	if arg.Length() > 1 {
+		return arg
+	}
+
+	switch {
+	case parser.peekLiteral("for"):
+		compFor := parser.parseComprehensionFor()
+		if compFor == nil {
+			return nil
 		}
-		andTest = parser.parseAndTest()
-		if andTest == nil {
+		arg.Append(compFor)
+	case parser.peekToken().ID == token.EQUAL:
+		arg.Append(NewTokenNode(parser.nextToken()))
+		test = parser.parseTest()
+		if test == nil {
 			return nil
 		}
-		orTest.Append(andTest)
+		arg.Append(test)
 	}
-	return orTest
+	return arg
 }
 
-// test: or_test ['if' or_test 'else' test] | lambdef
-func (parser *GrammarParser) parseTest() *Test {
-	test := NewTest()
-
-	orTest := parser.parseOrTest()
-	if orTest != nil {
-		test.Append(orTest)
-		next := parser.nextToken()
-		// Do not use `parser.expectLiteral`, this next part is optional
-		if next.IsLiteral("if") {
-			orTest = parser.parseOrTest()
-			if orTest != nil {
-				test.Append(orTest)
-				if parser.expectLiteral("else") {
-					elseTest := parser.parseTest()
-					if elseTest == nil {
-						return nil
-					}
-					test.Append(test)
-				}
-			}
-		} else {
-			parser.unreadToken(next)
+// comp_iter: comp_for | comp_if
+//
+// parseComprehensionIterator returns nil without an error when the next token does not start a comp_iter
+func (parser *GrammarParser) parseComprehensionIterator() *ComprehensionIterator {
+	compIter := NewComprehensionIterator()
+	switch {
+	case parser.peekLiteral("for"):
+		compFor := parser.parseComprehensionFor()
+		if compFor == nil {
+			return nil
 		}
-	} else {
-		// TODO: parser.parseLambDef()
+		compIter.SetChild(compFor)
+	case parser.peekLiteral("if"):
+		compIf := parser.parseComprehensionIf()
+		if compIf == nil {
+			return nil
+		}
+		compIter.SetChild(compIf)
+	default:
+		return nil
 	}
-	return test
+	return compIter
 }
 
-// testlist_star_expr: (test|star_expr) (',' (test|star_expr))* [',']
-func (parser *GrammarParser) parseTestlistStarExpression() *TestlistStarExpression {
-	testlistStarExpression := NewTestListStarExpression()
-
-	var expr TestlistStarExpressionChild
-	expr = parser.parseTest()
-	if expr == nil {
+// comp_for: 'for' exprlist 'in' or_test [comp_iter]
+func (parser *GrammarParser) parseComprehensionFor() *ComprehensionFor {
+	compFor := NewComprehensionFor()
+	compFor.Append(NewTokenNode(parser.nextToken()))
+	exprList := parser.parseExpressionList()
+	if exprList == nil {
 		return nil
 	}
-	testlistStarExpression.Append(expr)
-	return testlistStarExpression
-}
+	compFor.Append(exprList)
 
-// expr_stmt: testlist_star_expr (augassign (yield_expr|testlist) |
-//                      ('=' (yield_expr|testlist_star_expr))*)
-func (parser *GrammarParser) parseExpressionStatement() *ExpressionStatement {
-	exprStmt := NewExpressionStatement()
-	expr := parser.parseTestlistStarExpression()
-	if expr == nil {
+	in := parser.expectLiteral("in")
+	if in == nil {
 		return nil
 	}
-	exprStmt.Append(expr)
+	compFor.Append(in)
 
-	if false {
-	} else {
-		for {
-			next := parser.nextToken()
-			if next.ID != token.EQUAL {
-				parser.unreadToken(next)
-				break
-			}
-			exprStmt.Append(NewTokenNode(next))
-			expr := parser.parseTestlistStarExpression()
-			if expr == nil {
-				return nil
-			}
-			exprStmt.Append(expr)
-		}
+	orTest := parser.parseOrTest()
+	if orTest == nil {
+		return nil
 	}
+	compFor.Append(orTest)
 
-	return exprStmt
+	if compIter := parser.parseComprehensionIterator(); compIter != nil {
+		compFor.Append(compIter)
+	} else if len(parser.Errors) > 0 {
+		return nil
+	}
+	return compFor
 }
 
-// small_stmt: (expr_stmt | del_stmt | pass_stmt | flow_stmt |
-//              import_stmt | global_stmt | nonlocal_stmt | assert_stmt)
-func (parser *GrammarParser) parseSmallStatment() *SmallStatement {
-	smallStmt := NewSmallStatement()
-
-	var stmt SmallStatementChild
-	stmt = parser.parseExpressionStatement()
-	if stmt != nil {
-		smallStmt.SetChild(stmt)
+// comp_if: 'if' test_nocond [comp_iter]
+func (parser *GrammarParser) parseComprehensionIf() *ComprehensionIf {
+	compIf := NewComprehensionIf()
+	compIf.Append(NewTokenNode(parser.nextToken()))
+	test := parser.parseTestNocond()
+	if test == nil {
+		return nil
 	}
+	compIf.Append(test)
 
-	if stmt == nil {
+	if compIter := parser.parseComprehensionIterator(); compIter != nil {
+		compIf.Append(compIter)
+	} else if len(parser.Errors) > 0 {
 		return nil
 	}
-	return smallStmt
+	return compIf
 }
 
-// simple_stmt: small_stmt (';' small_stmt)* [';'] NEWLINE
-func (parser *GrammarParser) parseSimpleStatement() *SimpleStatement {
-	simpleStmt := NewSimpleStatement()
-	for {
-		smallStmt := parser.parseSmallStatment()
-		if smallStmt == nil {
-			break
-		}
-		simpleStmt.Append(smallStmt)
-		next := parser.nextToken()
-		if next.ID != token.SEMI {
-			parser.unreadToken(next)
-			break
-		}
-	}
-	next := parser.nextToken()
-	if next.ID != token.NEWLINE {
-		parser.addError("Expected \"NEWLINE\" instead found \"" + next.ID.String() + "\"")
-		return nil
+// yield_expr: 'yield' [yield_arg]
+func (parser *GrammarParser) parseYieldExpression() *YieldExpression {
+	yieldExpr := NewYieldExpression()
+	yieldExpr.Append(NewTokenNode(parser.nextToken()))
+	if !parser.peekLiteral("from") && !parser.startsTest() {
+		return yieldExpr
 	}
-	simpleStmt.Append(NewTokenNode(next))
 
-	// no small statements found
-	if simpleStmt.Length() == 0 {
+	yieldArg := parser.parseYieldArgument()
+	if yieldArg == nil {
 		return nil
 	}
-	return simpleStmt
+	yieldExpr.Append(yieldArg)
+	return yieldExpr
 }
 
-// stmt: simple_stmt | compound_stmt
-func (parser *GrammarParser) parseStatement() *Statement {
-	var next StatementChild
-	next = parser.parseSimpleStatement()
-	if next == nil {
-		next = parser.parseCompoundStatement()
+// yield_arg: 'from' test | testlist
+func (parser *GrammarParser) parseYieldArgument() *YieldArgument {
+	yieldArg := NewYieldArgument()
+	if parser.peekLiteral("from") {
+		yieldArg.Append(NewTokenNode(parser.nextToken()))
+		test := parser.parseTest()
+		if test == nil {
+			return nil
+		}
+		yieldArg.Append(test)
+		return yieldArg
 	}
 
-	if next == nil {
+	testlist := parser.parseTestlist()
+	if testlist == nil {
 		return nil
 	}
-
-	stmt := NewStatement()
-	stmt.SetChild(next)
-	return stmt
+	yieldArg.Append(testlist)
+	return yieldArg
 }
 
 // file_input: (NEWLINE | stmt)* ENDMARKER
 func (parser *GrammarParser) parseFileInput() *FileInput {
 	root := NewFileInput()
-	for parser.tokenizer.State() == errorcode.E_OK {
-		next := parser.nextToken()
+	for {
+		next := parser.peekToken()
 		if next.ID == token.NEWLINE {
-			root.Append(NewTokenNode(next))
-		} else if next.ID == token.ENDMARKER {
-			// Unread, so we can read in the expected value later
-			parser.unreadToken(next)
+			root.Append(NewTokenNode(parser.nextToken()))
+		} else if next.ID == token.ENDMARKER || next.ID == token.ERRORTOKEN {
+			// Leave it, so we can read in the expected value later
 			break
 		} else {
-			parser.unreadToken(next)
 			stmt := parser.parseStatement()
 			if stmt == nil {
 				break
@@ -606,21 +2387,28 @@ func (parser *GrammarParser) parseFileInput() *FileInput {
 			root.Append(stmt)
 		}
 	}
+	if len(parser.Errors) > 0 {
+		return nil
+	}
 
-	next := parser.nextToken()
-	if next.ID != token.ENDMARKER {
-		parser.addError("Expected \"ENDMARKER\" instead received \"" + next.ID.String() + "\"")
+	endmarker := parser.expect(token.ENDMARKER)
+	if endmarker == nil {
 		return nil
 	}
-	root.Append(NewTokenNode(next))
+	root.Append(endmarker)
 
 	return root
 }
 
 func NewGrammarParser(s *scanner.Scanner) *GrammarParser {
+	return NewGrammarParserContext(context.Background(), s)
+}
+
+// NewGrammarParserContext returns a GrammarParser which stops with an error once ctx is done
+func NewGrammarParserContext(ctx context.Context, s *scanner.Scanner) *GrammarParser {
 	return &GrammarParser{
-		tokenizer:   s,
-		tokenBuffer: make([]*token.Token, 0),
+		tokenizer: s,
+		tokens:    s.Tokens(ctx),
 	}
 }
 
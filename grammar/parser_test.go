@@ -0,0 +1,110 @@
+package grammar
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/brettlangdon/gython/errorcode"
+	"github.com/brettlangdon/gython/scanner"
+)
+
+func TestParseCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	lines := []string{"x = 1\n", "def f():\n", "    return x\n"}
+	// Cancel while the parser is in the middle of the input
+	prompt := func(string) (string, error) {
+		if len(lines) == 1 {
+			cancel()
+		}
+		if len(lines) == 0 {
+			return "", nil
+		}
+		line := lines[0]
+		lines = lines[1:]
+		return line, nil
+	}
+
+	gp := NewGrammarParserContext(ctx, scanner.NewInteractiveScanner(prompt, "", ""))
+	if start := gp.Parse(); start != nil {
+		t.Errorf("Parse() = %s after the context was cancelled", start.Repr())
+	}
+	if len(gp.Errors) == 0 {
+		t.Fatal("Parse() did not record an error after the context was cancelled")
+	}
+	if err := gp.Errors[0]; err.Code != errorcode.E_INTR || err.Message != context.Canceled.Error() {
+		t.Errorf("Parse() recorded %#v, want the cancellation", err)
+	}
+}
+
+func TestParseTooDeep(t *testing.T) {
+	tests := []struct {
+		source string
+		ok     bool
+	}{
+		{strings.Repeat("-", MAXSTACK-10) + "1\n", true},
+		{strings.Repeat("-", MAXSTACK) + "1\n", false},
+		{strings.Repeat("~", 1000000) + "1\n", false},
+		{strings.Repeat("not ", 1000000) + "1\n", false},
+		{strings.Repeat("lambda: ", 1000000) + "1\n", false},
+		{strings.Repeat("a if b else ", 1000000) + "1\n", false},
+		{strings.Repeat("a ** ", 1000000) + "1\n", false},
+		{"x = [" + strings.Repeat("[a for a in b if lambda: ", 100) + "1" + strings.Repeat("]", 101) + "\n", true},
+	}
+	for _, test := range tests {
+		gp := NewGrammarParser(scanner.NewBytesScanner([]byte(test.source)))
+		start := gp.Parse()
+		if test.ok {
+			if start == nil || len(gp.Errors) > 0 {
+				t.Errorf("Parse() of %.20q... returned %v", test.source, gp.Errors)
+			}
+			continue
+		}
+		if start != nil || len(gp.Errors) != 1 {
+			t.Errorf("Parse() of %.20q... = %v, %v, want one error", test.source, start, gp.Errors)
+			continue
+		}
+		if err := gp.Errors[0]; err.Code != errorcode.E_NOMEM || err.Type() != "MemoryError" || err.Message != "s_push: parser stack overflow" {
+			t.Errorf("Parse() of %.20q... recorded %#v, want a parser stack overflow", test.source, err)
+		}
+	}
+}
+
+func TestParseVersions(t *testing.T) {
+	versions := []scanner.Version{scanner.Python35, scanner.Python36, scanner.Python37}
+	tests := []struct {
+		source string
+		// The first error for each of versions, empty when source parses
+		want []string
+	}{
+		{"x: int = 1\n", []string{"1:1: variable annotation requires Python 3.6", "", ""}},
+		{"x.y: int\n", []string{"1:3: variable annotation requires Python 3.6", "", ""}},
+		// A trailing comma may follow *args, **kwargs or a bare * from 3.6
+		{"def f(a, *b,): pass\n", []string{"1:12: invalid syntax", "", ""}},
+		{"def f(*b, c,): pass\n", []string{"1:12: invalid syntax", "", ""}},
+		{"def f(**b,): pass\n", []string{"1:9: invalid syntax", "", ""}},
+		{"def f(*,): pass\n", []string{"1:8: invalid syntax", "", ""}},
+		{"lambda a, *b,: 0\n", []string{"1:13: invalid syntax", "", ""}},
+		{"lambda **b,: 0\n", []string{"1:10: invalid syntax", "", ""}},
+		{"lambda *,: 0\n", []string{"1:9: invalid syntax", "", ""}},
+		{"def f(a, b,): pass\n", []string{"", "", ""}},
+		// async and await are names outside an async def before 3.7
+		{"async = await\n", []string{"", "", "1:6: invalid syntax"}},
+		{"async def f():\n    await x\n", []string{"", "", ""}},
+	}
+	for _, test := range tests {
+		for i, version := range versions {
+			tokenizer := scanner.NewBytesScanner([]byte(test.source))
+			tokenizer.SetVersion(version)
+			gp := NewGrammarParser(tokenizer)
+			gp.Parse()
+			got := ""
+			if len(gp.Errors) > 0 {
+				got = gp.Errors[0].Error()
+			}
+			if got != test.want[i] {
+				t.Errorf("parsing %q for Python %s returned %q, want %q", test.source, version, got, test.want[i])
+			}
+		}
+	}
+}
@@ -18,6 +18,7 @@ func NewStatement() *Statement {
 }
 
 func (node *Statement) fileInputChild()           {}
+func (node *Statement) suiteChild()               {}
 func (node *Statement) SetChild(n StatementChild) { node.ParentNode.SetChild(n) }
 
 type SimpleStatementChild interface {
@@ -37,19 +38,9 @@ func NewSimpleStatement() *SimpleStatement {
 }
 
 func (node *SimpleStatement) stmtChild()                    {}
+func (node *SimpleStatement) suiteChild()                   {}
 func (node *SimpleStatement) Append(n SimpleStatementChild) { node.ListNode.Append(n) }
 
-type CompoundStatement struct {
-	BaseNode
-}
-
-func NewCompoundStatement() *CompoundStatement {
-	node := &CompoundStatement{}
-	node.initBaseNode(symbol.COMPOUND_STMT)
-	return node
-}
-func (node *CompoundStatement) stmtChild() {}
-
 type SmallStatementChild interface {
 	Node
 	smallStmtChild()
@@ -75,7 +66,6 @@ type ExpressionStatementChild interface {
 
 type ExpressionStatement struct {
 	ListNode
-	Expression *TestlistStarExpression
 }
 
 func NewExpressionStatement() *ExpressionStatement {
@@ -87,3 +77,401 @@ func NewExpressionStatement() *ExpressionStatement {
 
 func (node *ExpressionStatement) smallStmtChild()                   {}
 func (node *ExpressionStatement) Append(n ExpressionStatementChild) { node.ListNode.Append(n) }
+
+type AnnotatedAssignmentChild interface {
+	Node
+	annotatedAssignmentChild()
+}
+
+type AnnotatedAssignment struct {
+	ListNode
+}
+
+func NewAnnotatedAssignment() *AnnotatedAssignment {
+	node := &AnnotatedAssignment{}
+	node.initBaseNode(symbol.ANNASSIGN)
+	node.initListNode()
+	return node
+}
+
+func (node *AnnotatedAssignment) expressionStatementChild()         {}
+func (node *AnnotatedAssignment) Append(n AnnotatedAssignmentChild) { node.ListNode.Append(n) }
+
+type AugmentedAssignmentChild interface {
+	Node
+	augmentedAssignmentChild()
+}
+
+type AugmentedAssignment struct {
+	ListNode
+}
+
+func NewAugmentedAssignment() *AugmentedAssignment {
+	node := &AugmentedAssignment{}
+	node.initBaseNode(symbol.AUGASSIGN)
+	node.initListNode()
+	return node
+}
+
+func (node *AugmentedAssignment) expressionStatementChild()         {}
+func (node *AugmentedAssignment) Append(n AugmentedAssignmentChild) { node.ListNode.Append(n) }
+
+type DeleteStatementChild interface {
+	Node
+	deleteStatementChild()
+}
+
+type DeleteStatement struct {
+	ListNode
+}
+
+func NewDeleteStatement() *DeleteStatement {
+	node := &DeleteStatement{}
+	node.initBaseNode(symbol.DEL_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *DeleteStatement) smallStmtChild()               {}
+func (node *DeleteStatement) Append(n DeleteStatementChild) { node.ListNode.Append(n) }
+
+type PassStatementChild interface {
+	Node
+	passStatementChild()
+}
+
+type PassStatement struct {
+	ListNode
+}
+
+func NewPassStatement() *PassStatement {
+	node := &PassStatement{}
+	node.initBaseNode(symbol.PASS_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *PassStatement) smallStmtChild()             {}
+func (node *PassStatement) Append(n PassStatementChild) { node.ListNode.Append(n) }
+
+type FlowStatementChild interface {
+	Node
+	flowStatementChild()
+}
+
+type FlowStatement struct {
+	ParentNode
+}
+
+func NewFlowStatement() *FlowStatement {
+	node := &FlowStatement{}
+	node.initBaseNode(symbol.FLOW_STMT)
+	return node
+}
+
+func (node *FlowStatement) smallStmtChild()               {}
+func (node *FlowStatement) SetChild(n FlowStatementChild) { node.ParentNode.SetChild(n) }
+
+type BreakStatementChild interface {
+	Node
+	breakStatementChild()
+}
+
+type BreakStatement struct {
+	ListNode
+}
+
+func NewBreakStatement() *BreakStatement {
+	node := &BreakStatement{}
+	node.initBaseNode(symbol.BREAK_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *BreakStatement) flowStatementChild()          {}
+func (node *BreakStatement) Append(n BreakStatementChild) { node.ListNode.Append(n) }
+
+type ContinueStatementChild interface {
+	Node
+	continueStatementChild()
+}
+
+type ContinueStatement struct {
+	ListNode
+}
+
+func NewContinueStatement() *ContinueStatement {
+	node := &ContinueStatement{}
+	node.initBaseNode(symbol.CONTINUE_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *ContinueStatement) flowStatementChild()             {}
+func (node *ContinueStatement) Append(n ContinueStatementChild) { node.ListNode.Append(n) }
+
+type ReturnStatementChild interface {
+	Node
+	returnStatementChild()
+}
+
+type ReturnStatement struct {
+	ListNode
+}
+
+func NewReturnStatement() *ReturnStatement {
+	node := &ReturnStatement{}
+	node.initBaseNode(symbol.RETURN_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *ReturnStatement) flowStatementChild()           {}
+func (node *ReturnStatement) Append(n ReturnStatementChild) { node.ListNode.Append(n) }
+
+type YieldStatementChild interface {
+	Node
+	yieldStatementChild()
+}
+
+type YieldStatement struct {
+	ParentNode
+}
+
+func NewYieldStatement() *YieldStatement {
+	node := &YieldStatement{}
+	node.initBaseNode(symbol.YIELD_STMT)
+	return node
+}
+
+func (node *YieldStatement) flowStatementChild()            {}
+func (node *YieldStatement) SetChild(n YieldStatementChild) { node.ParentNode.SetChild(n) }
+
+type RaiseStatementChild interface {
+	Node
+	raiseStatementChild()
+}
+
+type RaiseStatement struct {
+	ListNode
+}
+
+func NewRaiseStatement() *RaiseStatement {
+	node := &RaiseStatement{}
+	node.initBaseNode(symbol.RAISE_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *RaiseStatement) flowStatementChild()          {}
+func (node *RaiseStatement) Append(n RaiseStatementChild) { node.ListNode.Append(n) }
+
+type ImportStatementChild interface {
+	Node
+	importStatementChild()
+}
+
+type ImportStatement struct {
+	ParentNode
+}
+
+func NewImportStatement() *ImportStatement {
+	node := &ImportStatement{}
+	node.initBaseNode(symbol.IMPORT_STMT)
+	return node
+}
+
+func (node *ImportStatement) smallStmtChild()                 {}
+func (node *ImportStatement) SetChild(n ImportStatementChild) { node.ParentNode.SetChild(n) }
+
+type ImportNameChild interface {
+	Node
+	importNameChild()
+}
+
+type ImportName struct {
+	ListNode
+}
+
+func NewImportName() *ImportName {
+	node := &ImportName{}
+	node.initBaseNode(symbol.IMPORT_NAME)
+	node.initListNode()
+	return node
+}
+
+func (node *ImportName) importStatementChild()    {}
+func (node *ImportName) Append(n ImportNameChild) { node.ListNode.Append(n) }
+
+type ImportFromChild interface {
+	Node
+	importFromChild()
+}
+
+type ImportFrom struct {
+	ListNode
+}
+
+func NewImportFrom() *ImportFrom {
+	node := &ImportFrom{}
+	node.initBaseNode(symbol.IMPORT_FROM)
+	node.initListNode()
+	return node
+}
+
+func (node *ImportFrom) importStatementChild()    {}
+func (node *ImportFrom) Append(n ImportFromChild) { node.ListNode.Append(n) }
+
+type ImportAsNameChild interface {
+	Node
+	importAsNameChild()
+}
+
+type ImportAsName struct {
+	ListNode
+}
+
+func NewImportAsName() *ImportAsName {
+	node := &ImportAsName{}
+	node.initBaseNode(symbol.IMPORT_AS_NAME)
+	node.initListNode()
+	return node
+}
+
+func (node *ImportAsName) importAsNamesChild()        {}
+func (node *ImportAsName) Append(n ImportAsNameChild) { node.ListNode.Append(n) }
+
+type DottedAsNameChild interface {
+	Node
+	dottedAsNameChild()
+}
+
+type DottedAsName struct {
+	ListNode
+}
+
+func NewDottedAsName() *DottedAsName {
+	node := &DottedAsName{}
+	node.initBaseNode(symbol.DOTTED_AS_NAME)
+	node.initListNode()
+	return node
+}
+
+func (node *DottedAsName) dottedAsNamesChild()        {}
+func (node *DottedAsName) Append(n DottedAsNameChild) { node.ListNode.Append(n) }
+
+type ImportAsNamesChild interface {
+	Node
+	importAsNamesChild()
+}
+
+type ImportAsNames struct {
+	ListNode
+}
+
+func NewImportAsNames() *ImportAsNames {
+	node := &ImportAsNames{}
+	node.initBaseNode(symbol.IMPORT_AS_NAMES)
+	node.initListNode()
+	return node
+}
+
+func (node *ImportAsNames) importFromChild()            {}
+func (node *ImportAsNames) Append(n ImportAsNamesChild) { node.ListNode.Append(n) }
+
+type DottedAsNamesChild interface {
+	Node
+	dottedAsNamesChild()
+}
+
+type DottedAsNames struct {
+	ListNode
+}
+
+func NewDottedAsNames() *DottedAsNames {
+	node := &DottedAsNames{}
+	node.initBaseNode(symbol.DOTTED_AS_NAMES)
+	node.initListNode()
+	return node
+}
+
+func (node *DottedAsNames) importNameChild()            {}
+func (node *DottedAsNames) Append(n DottedAsNamesChild) { node.ListNode.Append(n) }
+
+type DottedNameChild interface {
+	Node
+	dottedNameChild()
+}
+
+type DottedName struct {
+	ListNode
+}
+
+func NewDottedName() *DottedName {
+	node := &DottedName{}
+	node.initBaseNode(symbol.DOTTED_NAME)
+	node.initListNode()
+	return node
+}
+
+func (node *DottedName) decoratorChild()          {}
+func (node *DottedName) dottedAsNameChild()       {}
+func (node *DottedName) importFromChild()         {}
+func (node *DottedName) Append(n DottedNameChild) { node.ListNode.Append(n) }
+
+type GlobalStatementChild interface {
+	Node
+	globalStatementChild()
+}
+
+type GlobalStatement struct {
+	ListNode
+}
+
+func NewGlobalStatement() *GlobalStatement {
+	node := &GlobalStatement{}
+	node.initBaseNode(symbol.GLOBAL_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *GlobalStatement) smallStmtChild()               {}
+func (node *GlobalStatement) Append(n GlobalStatementChild) { node.ListNode.Append(n) }
+
+type NonlocalStatementChild interface {
+	Node
+	nonlocalStatementChild()
+}
+
+type NonlocalStatement struct {
+	ListNode
+}
+
+func NewNonlocalStatement() *NonlocalStatement {
+	node := &NonlocalStatement{}
+	node.initBaseNode(symbol.NONLOCAL_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *NonlocalStatement) smallStmtChild()                 {}
+func (node *NonlocalStatement) Append(n NonlocalStatementChild) { node.ListNode.Append(n) }
+
+type AssertStatementChild interface {
+	Node
+	assertStatementChild()
+}
+
+type AssertStatement struct {
+	ListNode
+}
+
+func NewAssertStatement() *AssertStatement {
+	node := &AssertStatement{}
+	node.initBaseNode(symbol.ASSERT_STMT)
+	node.initListNode()
+	return node
+}
+
+func (node *AssertStatement) smallStmtChild()               {}
+func (node *AssertStatement) Append(n AssertStatementChild) { node.ListNode.Append(n) }
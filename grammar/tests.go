@@ -14,13 +14,90 @@ type Test struct {
 func NewTest() *Test {
 	node := &Test{}
 	node.initBaseNode(symbol.TEST)
+	node.initListNode()
 	return node
 }
 
-func (node *Test) testlistStarExpressionChild() {}
+func (node *Test) annotatedAssignmentChild()    {}
+func (node *Test) argumentChild()               {}
+func (node *Test) assertStatementChild()        {}
+func (node *Test) dictOrSetMakerChild()         {}
+func (node *Test) exceptClauseChild()           {}
+func (node *Test) functionDefinitionChild()     {}
+func (node *Test) ifStatementChild()            {}
+func (node *Test) lambdaDefinitionChild()       {}
+func (node *Test) raiseStatementChild()         {}
+func (node *Test) sliceOperationChild()         {}
+func (node *Test) subscriptChild()              {}
 func (node *Test) testChild()                   {}
+func (node *Test) testlistChild()               {}
+func (node *Test) testlistComprehensionChild()  {}
+func (node *Test) testlistStarExpressionChild() {}
+func (node *Test) typedArgsListChild()          {}
+func (node *Test) typedFunctionParameterChild() {}
+func (node *Test) varArgsListChild()            {}
+func (node *Test) whileStatementChild()         {}
+func (node *Test) withItemChild()               {}
+func (node *Test) yieldArgumentChild()          {}
 func (node *Test) Append(n TestChild)           { node.ListNode.Append(n) }
 
+type TestNocondChild interface {
+	Node
+	testNocondChild()
+}
+
+type TestNocond struct {
+	ParentNode
+}
+
+func NewTestNocond() *TestNocond {
+	node := &TestNocond{}
+	node.initBaseNode(symbol.TEST_NOCOND)
+	return node
+}
+
+func (node *TestNocond) comprehensionIfChild()        {}
+func (node *TestNocond) lambdaDefinitionNocondChild() {}
+func (node *TestNocond) SetChild(n TestNocondChild)   { node.ParentNode.SetChild(n) }
+
+type LambdaDefinitionChild interface {
+	Node
+	lambdaDefinitionChild()
+}
+
+type LambdaDefinition struct {
+	ListNode
+}
+
+func NewLambdaDefinition() *LambdaDefinition {
+	node := &LambdaDefinition{}
+	node.initBaseNode(symbol.LAMBDEF)
+	node.initListNode()
+	return node
+}
+
+func (node *LambdaDefinition) testChild()                     {}
+func (node *LambdaDefinition) Append(n LambdaDefinitionChild) { node.ListNode.Append(n) }
+
+type LambdaDefinitionNocondChild interface {
+	Node
+	lambdaDefinitionNocondChild()
+}
+
+type LambdaDefinitionNocond struct {
+	ListNode
+}
+
+func NewLambdaDefinitionNocond() *LambdaDefinitionNocond {
+	node := &LambdaDefinitionNocond{}
+	node.initBaseNode(symbol.LAMBDEF_NOCOND)
+	node.initListNode()
+	return node
+}
+
+func (node *LambdaDefinitionNocond) testNocondChild()                     {}
+func (node *LambdaDefinitionNocond) Append(n LambdaDefinitionNocondChild) { node.ListNode.Append(n) }
+
 type OrTestChild interface {
 	Node
 	orTestChild()
@@ -33,11 +110,14 @@ type OrTest struct {
 func NewOrTest() *OrTest {
 	node := &OrTest{}
 	node.initBaseNode(symbol.OR_TEST)
+	node.initListNode()
 	return node
 }
 
-func (node *OrTest) testChild()           {}
-func (node *OrTest) Append(n OrTestChild) { node.ListNode.Append(n) }
+func (node *OrTest) comprehensionForChild() {}
+func (node *OrTest) testChild()             {}
+func (node *OrTest) testNocondChild()       {}
+func (node *OrTest) Append(n OrTestChild)   { node.ListNode.Append(n) }
 
 type AndTestChild interface {
 	Node
@@ -51,6 +131,7 @@ type AndTest struct {
 func NewAndTest() *AndTest {
 	node := &AndTest{}
 	node.initBaseNode(symbol.AND_TEST)
+	node.initListNode()
 	return node
 }
 
@@ -69,9 +150,10 @@ type NotTest struct {
 func NewNotTest() *NotTest {
 	node := &NotTest{}
 	node.initBaseNode(symbol.NOT_TEST)
+	node.initListNode()
 	return node
 }
 
-func (node *NotTest) notTestChild()         {}
 func (node *NotTest) andTestChild()         {}
+func (node *NotTest) notTestChild()         {}
 func (node *NotTest) Append(n NotTestChild) { node.ListNode.Append(n) }
@@ -0,0 +1,18 @@
+package gython
+
+type Bool struct {
+	Value bool
+}
+
+func (b *Bool) object() {}
+func (b *Bool) Repr() string {
+	if b.Value {
+		return "True"
+	}
+	return "False"
+}
+
+var (
+	True  *Bool = &Bool{Value: true}
+	False *Bool = &Bool{Value: false}
+)
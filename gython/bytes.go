@@ -1,5 +1,7 @@
 package gython
 
+import "fmt"
+
 type Bytes struct {
 	value []byte
 }
@@ -10,6 +12,13 @@ func NewBytes() *Bytes {
 	}
 }
 
+// NewBytesFrom returns a Bytes holding value
+func NewBytesFrom(value []byte) *Bytes {
+	return &Bytes{
+		value: value,
+	}
+}
+
 func (bytes *Bytes) object() {}
 func (bytes *Bytes) Append(b byte) {
 	bytes.value = append(bytes.value, b)
@@ -20,3 +29,29 @@ func (bytes *Bytes) String() string {
 func (bytes *Bytes) Value() []byte {
 	return bytes.value
 }
+
+// Repr quotes the bytes like Python's bytes.__repr__
+func (bytes *Bytes) Repr() string {
+	quote := reprQuote(string(bytes.value))
+
+	repr := make([]byte, 0, len(bytes.value)+3)
+	repr = append(repr, 'b', quote)
+	for _, b := range bytes.value {
+		switch {
+		case b == quote || b == '\\':
+			repr = append(repr, '\\', b)
+		case b == '\t':
+			repr = append(repr, `\t`...)
+		case b == '\n':
+			repr = append(repr, `\n`...)
+		case b == '\r':
+			repr = append(repr, `\r`...)
+		case b < ' ' || b >= 0x7f:
+			repr = append(repr, fmt.Sprintf(`\x%02x`, b)...)
+		default:
+			repr = append(repr, b)
+		}
+	}
+	repr = append(repr, quote)
+	return string(repr)
+}
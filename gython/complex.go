@@ -0,0 +1,28 @@
+package gython
+
+import "math"
+
+type Complex struct {
+	Real float64
+	Imag float64
+}
+
+func NewComplex(real float64, imag float64) *Complex {
+	return &Complex{
+		Real: real,
+		Imag: imag,
+	}
+}
+
+func (complex *Complex) object() {}
+func (complex *Complex) Repr() string {
+	// A zero real part is left out, unless it is negative zero
+	if complex.Real == 0 && !math.Signbit(complex.Real) {
+		return formatFloat(complex.Imag, false) + "j"
+	}
+	imag := formatFloat(complex.Imag, false)
+	if imag[0] != '-' {
+		imag = "+" + imag
+	}
+	return "(" + formatFloat(complex.Real, false) + imag + "j)"
+}
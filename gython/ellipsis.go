@@ -0,0 +1,8 @@
+package gython
+
+type _Ellipsis struct{}
+
+func (ellipsis *_Ellipsis) object()      {}
+func (ellipsis *_Ellipsis) Repr() string { return "Ellipsis" }
+
+var Ellipsis *_Ellipsis = &_Ellipsis{}
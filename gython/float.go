@@ -1,5 +1,11 @@
 package gython
 
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
 type Float struct {
 	Value float64
 }
@@ -11,3 +17,63 @@ func NewFloat(i float64) *Float {
 }
 
 func (float *Float) object() {}
+func (float *Float) Repr() string {
+	return formatFloat(float.Value, true)
+}
+
+// formatFloat formats f like CPython's float_repr: the shortest string which
+// round trips, in scientific notation when the exponent is below -4 or at least 16.
+// addDot0 makes integral values end in ".0", as floats do but complex parts do not.
+func formatFloat(f float64, addDot0 bool) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	case math.IsNaN(f):
+		return "nan"
+	}
+
+	// Shortest digits and decimal exponent, e.g. "-1.5e+03"
+	repr := strconv.FormatFloat(f, 'e', -1, 64)
+	mantissa, exp := repr, 0
+	if i := strings.IndexByte(repr, 'e'); i >= 0 {
+		mantissa = repr[:i]
+		exp, _ = strconv.Atoi(repr[i+1:])
+	}
+	sign := ""
+	if strings.HasPrefix(mantissa, "-") {
+		sign, mantissa = "-", mantissa[1:]
+	}
+	digits := strings.Replace(mantissa, ".", "", 1)
+
+	if exp < -4 || exp >= 16 {
+		repr = digits[:1]
+		if len(digits) > 1 {
+			repr += "." + digits[1:]
+		}
+		expSign := "+"
+		if exp < 0 {
+			expSign, exp = "-", -exp
+		}
+		expDigits := strconv.Itoa(exp)
+		if len(expDigits) < 2 {
+			expDigits = "0" + expDigits
+		}
+		return sign + repr + "e" + expSign + expDigits
+	}
+
+	point := exp + 1
+	switch {
+	case point <= 0:
+		repr = "0." + strings.Repeat("0", -point) + digits
+	case point >= len(digits):
+		repr = digits + strings.Repeat("0", point-len(digits))
+		if addDot0 {
+			repr += ".0"
+		}
+	default:
+		repr = digits[:point] + "." + digits[point:]
+	}
+	return sign + repr
+}
@@ -1,5 +1,18 @@
 package gython
 
+import "math/big"
+
 type Long struct {
-	Value float64
+	Value *big.Int
+}
+
+func NewLong(value *big.Int) *Long {
+	return &Long{
+		Value: value,
+	}
+}
+
+func (long *Long) object() {}
+func (long *Long) Repr() string {
+	return long.Value.String()
 }
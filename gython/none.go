@@ -2,6 +2,7 @@ package gython
 
 type _None struct{}
 
-func (none *_None) object() {}
+func (none *_None) object()      {}
+func (none *_None) Repr() string { return "None" }
 
 var None *_None = &_None{}
@@ -1,5 +1,17 @@
 package gython
 
+import "fmt"
+
 type Object interface {
 	object()
 }
+
+// Repr returns the Python representation of object
+func Repr(object Object) string {
+	if repr, ok := object.(interface {
+		Repr() string
+	}); ok {
+		return repr.Repr()
+	}
+	return fmt.Sprintf("%v", object)
+}
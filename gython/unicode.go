@@ -1,13 +1,78 @@
 package gython
 
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
 type Unicode struct {
 	Value []byte
 }
 
-func (unicode *Unicode) object() {}
+func (str *Unicode) object() {}
 
 func NewUnicode(value []byte) *Unicode {
 	return &Unicode{
 		Value: value,
 	}
 }
+
+func (str *Unicode) String() string {
+	return string(str.Value)
+}
+
+// Repr quotes the string like Python's str.__repr__
+func (str *Unicode) Repr() string {
+	value := string(str.Value)
+	quote := reprQuote(value)
+
+	repr := make([]byte, 0, len(value)+2)
+	repr = append(repr, quote)
+	for i := 0; i < len(value); {
+		r, size := decodeCodePoint(value[i:])
+		i += size
+		switch {
+		case r == rune(quote) || r == '\\':
+			repr = append(repr, '\\', byte(r))
+		case r == '\t':
+			repr = append(repr, `\t`...)
+		case r == '\n':
+			repr = append(repr, `\n`...)
+		case r == '\r':
+			repr = append(repr, `\r`...)
+		case r < ' ' || r == 0x7f:
+			repr = append(repr, fmt.Sprintf(`\x%02x`, r)...)
+		case r < utf8.RuneSelf || unicode.IsPrint(r):
+			repr = utf8.AppendRune(repr, r)
+		case r <= 0xff:
+			repr = append(repr, fmt.Sprintf(`\x%02x`, r)...)
+		case r <= 0xffff:
+			repr = append(repr, fmt.Sprintf(`\u%04x`, r)...)
+		default:
+			repr = append(repr, fmt.Sprintf(`\U%08x`, r)...)
+		}
+	}
+	repr = append(repr, quote)
+	return string(repr)
+}
+
+// decodeCodePoint decodes the first character of value like utf8.DecodeRuneInString,
+// but also accepts a lone surrogate in its three byte form
+func decodeCodePoint(value string) (rune, int) {
+	r, size := utf8.DecodeRuneInString(value)
+	if r == utf8.RuneError && size == 1 && len(value) >= 3 &&
+		value[0] == 0xed && value[1]&0xe0 == 0xa0 && value[2]&0xc0 == 0x80 {
+		return 0xd000 | rune(value[1]&0x3f)<<6 | rune(value[2]&0x3f), 3
+	}
+	return r, size
+}
+
+// reprQuote picks single quotes, unless value contains them and no double quotes
+func reprQuote(value string) byte {
+	if strings.ContainsRune(value, '\'') && !strings.ContainsRune(value, '"') {
+		return '"'
+	}
+	return '\''
+}
@@ -0,0 +1,33 @@
+package scanner
+
+import (
+	"fmt"
+
+	"github.com/brettlangdon/gython/errorcode"
+)
+
+// ScanError describes why the scanner produced an ERRORTOKEN
+type ScanError struct {
+	Code    errorcode.ErrorCode
+	Line    int
+	Column  int
+	Message string
+}
+
+func NewScanError(code errorcode.ErrorCode, line int, column int) *ScanError {
+	return &ScanError{
+		Code:    code,
+		Line:    line,
+		Column:  column,
+		Message: code.Message(),
+	}
+}
+
+func (err *ScanError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", err.Line, err.Column, err.Message)
+}
+
+// Type returns the name of the Python exception CPython raises for this error
+func (err *ScanError) Type() string {
+	return err.Code.ExceptionName()
+}
@@ -0,0 +1,88 @@
+package scanner
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// validateEscape consumes and checks the remainder of an escape sequence
+// whose backslash is at the byte offset within the literal body. It returns the error
+// message CPython would give for a malformed escape, or "" when it is valid.
+func (scanner *Scanner) validateEscape(positions *Positions, escape rune, bytes bool, offset int) string {
+	digits := 0
+	switch escape {
+	case 'x':
+		digits = 2
+	case 'u':
+		digits = 4
+	case 'U':
+		digits = 8
+	case 'N':
+		if !bytes {
+			return scanner.validateNamedEscape(positions, offset)
+		}
+	}
+	if digits == 0 || (bytes && escape != 'x') {
+		// Unknown escapes are kept verbatim
+		return ""
+	}
+
+	value := ""
+	for len(value) < digits {
+		pos := scanner.nextPosition()
+		if !IsXDigit(pos.Char) {
+			scanner.unreadPosition(pos)
+			break
+		}
+		positions.Append(pos)
+		value += string(pos.Char)
+	}
+
+	if bytes {
+		if len(value) < digits {
+			return fmt.Sprintf("(value error) invalid \\x escape at position %d", offset)
+		}
+		return ""
+	}
+
+	end := offset + 1 + len(value)
+	if len(value) < digits {
+		format := map[rune]string{'x': "\\xXX", 'u': "\\uXXXX", 'U': "\\UXXXXXXXX"}[escape]
+		return unicodeEscapeError(offset, end, "truncated "+format+" escape")
+	}
+	if escape == 'U' {
+		if code, _ := strconv.ParseUint(value, 16, 32); code > 0x10ffff {
+			return unicodeEscapeError(offset, end, "illegal Unicode character")
+		}
+	}
+	return ""
+}
+
+// validateNamedEscape checks the `{name}` part of a \N escape
+func (scanner *Scanner) validateNamedEscape(positions *Positions, offset int) string {
+	pos := scanner.nextPosition()
+	if pos.Char != '{' {
+		scanner.unreadPosition(pos)
+		return unicodeEscapeError(offset, offset+1, "malformed \\N character escape")
+	}
+	positions.Append(pos)
+
+	length := 0
+	for {
+		pos = scanner.nextPosition()
+		if pos.Char == '}' && length > 0 {
+			positions.Append(pos)
+			return ""
+		}
+		if !IsAlphaNumeric(pos.Char) && pos.Char != ' ' && pos.Char != '-' {
+			scanner.unreadPosition(pos)
+			return unicodeEscapeError(offset, offset+2+length, "malformed \\N character escape")
+		}
+		positions.Append(pos)
+		length++
+	}
+}
+
+func unicodeEscapeError(start int, end int, reason string) string {
+	return fmt.Sprintf("(unicode error) 'unicodeescape' codec can't decode bytes in position %d-%d: %s", start, end, reason)
+}
@@ -0,0 +1,41 @@
+package scanner
+
+import (
+	"testing"
+
+	"github.com/brettlangdon/gython/token"
+)
+
+func TestEscapeErrors(t *testing.T) {
+	tests := []struct {
+		source string
+		err    string
+	}{
+		{`'\x1'`, `1:0: (unicode error) 'unicodeescape' codec can't decode bytes in position 0-2: truncated \xXX escape`},
+		{`'éé\x1'`, `1:0: (unicode error) 'unicodeescape' codec can't decode bytes in position 4-6: truncated \xXX escape`},
+		{`x = 'é\u12'`, `1:4: (unicode error) 'unicodeescape' codec can't decode bytes in position 2-5: truncated \uXXXX escape`},
+		{`'a\U00110000'`, `1:0: (unicode error) 'unicodeescape' codec can't decode bytes in position 1-10: illegal Unicode character`},
+		{`'é\N{ab é'`, `1:0: (unicode error) 'unicodeescape' codec can't decode bytes in position 2-7: malformed \N character escape`},
+		{`'\N'`, `1:0: (unicode error) 'unicodeescape' codec can't decode bytes in position 0-1: malformed \N character escape`},
+		{`b'a\x'`, `1:0: (value error) invalid \x escape at position 1`},
+	}
+	for _, test := range tests {
+		scanner := NewBytesScanner([]byte(test.source + "\n"))
+		for {
+			tok := scanner.NextToken()
+			if tok.ID == token.ERRORTOKEN || tok.ID == token.ENDMARKER {
+				break
+			}
+		}
+		if err := scanner.Err(); err == nil || err.Error() != test.err {
+			t.Errorf("scanning %q returned %v, want %q", test.source, err, test.err)
+		}
+	}
+
+	for _, source := range []string{`'\x41é\U0001F600\N{SNOWMAN}\q'`, `b'\x41'`, `r'\x'`} {
+		scanner := NewBytesScanner([]byte(source + "\n"))
+		if tok := scanner.NextToken(); tok.ID != token.STRING {
+			t.Errorf("scanning %q returned %s: %v", source, tok, scanner.Err())
+		}
+	}
+}
@@ -14,6 +14,14 @@ func IsXDigit(r rune) bool {
 	return IsDigit(r) || (r >= 65 && r <= 70) || (r >= 97 && r <= 102)
 }
 
+func IsOctDigit(r rune) bool {
+	return r >= '0' && r <= '7'
+}
+
+func IsBinDigit(r rune) bool {
+	return r == '0' || r == '1'
+}
+
 func IsAlphaNumeric(r rune) bool {
 	return IsLetter(r) || IsDigit(r)
 }
@@ -0,0 +1,362 @@
+package scanner
+
+import (
+	"fmt"
+
+	"github.com/brettlangdon/gython/token"
+)
+
+// Edit replaces the bytes in [Start, End) of a source buffer with Text
+type Edit struct {
+	Start int
+	End   int
+	Text  string
+}
+
+// IncrementalScanner keeps the tokens of an editor buffer up to date. After an
+// edit only the affected lines are scanned again: scanning restarts at the
+// last logical line before the edit and the previous tokens are reused as soon
+// as the scanner reaches a line start it has already seen in the same state.
+type IncrementalScanner struct {
+	err      *ScanError
+	source   string
+	tabCheck TabCheck
+	tokens   []*token.Token
+	version  Version
+	warnings []*ScanError
+}
+
+// NewIncrementalScanner returns an IncrementalScanner for source. tokens are the
+// tokens previously produced for source, or nil to scan it on first use.
+func NewIncrementalScanner(source []byte, tokens []*token.Token) *IncrementalScanner {
+	return &IncrementalScanner{
+		source:   string(source),
+		tabCheck: TabCheckError,
+		tokens:   tokens,
+		version:  DefaultVersion,
+	}
+}
+
+// SetTabCheck configures the tab check of the underlying scanner, the next call to Tokens scans everything again
+func (inc *IncrementalScanner) SetTabCheck(check TabCheck) {
+	inc.tabCheck = check
+	inc.tokens = nil
+}
+
+// SetVersion selects the Python version of the underlying scanner, the next call to Tokens scans everything again
+func (inc *IncrementalScanner) SetVersion(version Version) {
+	inc.version = version
+	inc.tokens = nil
+}
+
+// Source returns the current contents of the buffer
+func (inc *IncrementalScanner) Source() string {
+	return inc.source
+}
+
+// Err returns the error which caused the final ERRORTOKEN of Tokens, or nil
+func (inc *IncrementalScanner) Err() *ScanError {
+	return inc.err
+}
+
+// Warnings returns the warnings for the current buffer, like Scanner.Warnings. The warning of
+// TabCheckWarn is kept across edits which leave its line alone, and moves with that line.
+func (inc *IncrementalScanner) Warnings() []*ScanError {
+	return inc.warnings
+}
+
+// Tokens returns the tokens of the current buffer, ending with ENDMARKER or ERRORTOKEN
+func (inc *IncrementalScanner) Tokens() []*token.Token {
+	if inc.tokens == nil {
+		inc.err = nil
+		inc.warnings = nil
+		inc.tokens = inc.scan(inc.initialCheckpoint(), nil, nil)
+	}
+	return inc.tokens
+}
+
+// Update applies edit to the buffer and returns the updated tokens
+func (inc *IncrementalScanner) Update(edit Edit) ([]*token.Token, error) {
+	if edit.Start < 0 || edit.End < edit.Start || edit.End > len(inc.source) {
+		return nil, fmt.Errorf("edit [%d,%d) is outside of the source of length %d", edit.Start, edit.End, len(inc.source))
+	}
+
+	previous := inc.Tokens()
+	checkpoints := inc.checkpoints(previous)
+	inc.source = inc.source[:edit.Start] + edit.Text + inc.source[edit.End:]
+
+	// Nothing before the start of the line containing the edit can change,
+	// an edit on the first line may also add or remove a byte order mark
+	restart := inc.initialCheckpoint()
+	resync := make(map[int]checkpoint)
+	for i, cp := range checkpoints {
+		if i > 0 && cp.offset <= edit.Start {
+			restart = cp
+		}
+		if cp.offset >= edit.End {
+			resync[cp.offset] = cp
+		}
+	}
+
+	delta := len(edit.Text) - (edit.End - edit.Start)
+	editEnd := edit.Start + len(edit.Text)
+	previousWarnings := inc.warnings
+	var reusedWarnings []*ScanError
+	tail := func(tok *token.Token, scanner *Scanner) []*token.Token {
+		if tok.OffsetEnd < editEnd {
+			return nil
+		}
+		cp, ok := resync[tok.OffsetEnd-delta]
+		if !ok || !cp.state.equal(scanner.lineState()) {
+			return nil
+		}
+		// When the old warning was in the part scanned again, the old tokens after it were
+		// never checked for another one, so they can't be reused until there is a new warning
+		if len(scanner.Warnings()) == 0 {
+			for _, warning := range previousWarnings {
+				if warning.Line >= restart.line && warning.Line < cp.line {
+					return nil
+				}
+			}
+		}
+
+		// The rest of the buffer is unchanged and scanned in the same state
+		lineDelta := tok.LineEnd + 1 - cp.line
+		tokens := make([]*token.Token, 0, len(previous)-cp.index)
+		for _, old := range previous[cp.index:] {
+			shifted := *old
+			shifted.LineStart += lineDelta
+			shifted.LineEnd += lineDelta
+			shifted.OffsetStart += delta
+			shifted.OffsetEnd += delta
+			tokens = append(tokens, &shifted)
+		}
+		if inc.err != nil {
+			err := *inc.err
+			err.Line += lineDelta
+			inc.err = &err
+		}
+		for _, warning := range previousWarnings {
+			if warning.Line >= cp.line {
+				shifted := *warning
+				shifted.Line += lineDelta
+				reusedWarnings = append(reusedWarnings, &shifted)
+			}
+		}
+		return tokens
+	}
+
+	prefix := previous[:restart.index:restart.index]
+	inc.tokens = inc.scan(restart, prefix, tail)
+	// Only the first inconsistent indentation is warned about
+	if len(inc.warnings) == 0 {
+		inc.warnings = reusedWarnings
+	}
+	return inc.tokens, nil
+}
+
+// scan appends the tokens found from restart onwards to prefix. After every
+// NEWLINE past the edit, tail may return the remaining tokens to stop early.
+func (inc *IncrementalScanner) scan(restart checkpoint, prefix []*token.Token, tail func(*token.Token, *Scanner) []*token.Token) []*token.Token {
+	scanner := inc.newScanner()
+	scanner.resume(restart)
+	// The warnings before the restart still hold, once there is one the scanner only warns once
+	warnings := make([]*ScanError, 0)
+	for _, warning := range inc.warnings {
+		if warning.Line < restart.line {
+			warnings = append(warnings, warning)
+		}
+	}
+	if len(warnings) > 0 {
+		scanner.SetTabCheck(TabCheckOff)
+	}
+	defer func() {
+		inc.warnings = append(warnings, scanner.Warnings()...)
+	}()
+
+	tokens := prefix
+	for {
+		tok := scanner.NextToken()
+		tokens = append(tokens, tok)
+		switch tok.ID {
+		case token.ERRORTOKEN:
+			inc.err = scanner.Err()
+			return tokens
+		case token.ENDMARKER:
+			inc.err = nil
+			return tokens
+		case token.NEWLINE:
+			if tok.Literal != "" && tail != nil {
+				if rest := tail(tok, scanner); rest != nil {
+					return append(tokens, rest...)
+				}
+			}
+		}
+	}
+}
+
+func (inc *IncrementalScanner) newScanner() *Scanner {
+	scanner := NewBytesScanner(nil)
+	scanner.source = inc.source
+	scanner.SetTabCheck(inc.tabCheck)
+	scanner.SetVersion(inc.version)
+	return scanner
+}
+
+// checkpoint is a line start in a token list where scanning can resume
+type checkpoint struct {
+	// index of the first token after the line start
+	index  int
+	offset int
+	line   int
+	state  lineState
+}
+
+// lineState is the scanner state carried from one logical line to the next.
+// Brackets are closed at the start of a logical line unless the source
+// closed more brackets than it opened.
+type lineState struct {
+	brackets        int
+	indentation     []int
+	indentationAlt  []int
+	asyncDef        bool
+	asyncDefIndent  int
+	asyncDefNewline bool
+}
+
+func (state lineState) copy() lineState {
+	state.indentation = append([]int(nil), state.indentation...)
+	state.indentationAlt = append([]int(nil), state.indentationAlt...)
+	return state
+}
+
+func (state lineState) equal(other lineState) bool {
+	if len(state.indentation) != len(other.indentation) {
+		return false
+	}
+	for i := range state.indentation {
+		if state.indentation[i] != other.indentation[i] || state.indentationAlt[i] != other.indentationAlt[i] {
+			return false
+		}
+	}
+	return state.brackets == other.brackets &&
+		state.asyncDef == other.asyncDef &&
+		state.asyncDefIndent == other.asyncDefIndent &&
+		state.asyncDefNewline == other.asyncDefNewline
+}
+
+func (inc *IncrementalScanner) initialCheckpoint() checkpoint {
+	return checkpoint{
+		index:  0,
+		offset: sourceStart(inc.source),
+		line:   1,
+		state: lineState{
+			indentation:    []int{0},
+			indentationAlt: []int{0},
+		},
+	}
+}
+
+// checkpoints replays tokens to recover the scanner state at the start of every logical line
+func (inc *IncrementalScanner) checkpoints(tokens []*token.Token) []checkpoint {
+	scanner := inc.newScanner()
+	initial := inc.initialCheckpoint()
+	checkpoints := []checkpoint{initial}
+
+	state := initial.state.copy()
+	for i, tok := range tokens {
+		switch tok.ID {
+		case token.ERRORTOKEN, token.ENDMARKER:
+			return checkpoints
+		case token.INDENT:
+			col, altcol := scanner.indentationColumns(tok.Literal)
+			state.indentation = append(state.indentation, col)
+			state.indentationAlt = append(state.indentationAlt, altcol)
+			continue
+		case token.DEDENT:
+			if len(state.indentation) > 1 {
+				state.indentation = state.indentation[:len(state.indentation)-1]
+				state.indentationAlt = state.indentationAlt[:len(state.indentationAlt)-1]
+			}
+			continue
+		}
+
+		// Mirrors the check for the end of an async function in NextToken
+		if state.asyncDef && state.brackets == 0 && state.asyncDefNewline && state.asyncDefIndent >= len(state.indentation)-1 {
+			state.asyncDef = false
+			state.asyncDefIndent = 0
+			state.asyncDefNewline = false
+		}
+
+		switch tok.ID {
+		case token.LPAR, token.LSQB, token.LBRACE:
+			state.brackets++
+		case token.RPAR, token.RSQB, token.RBRACE:
+			state.brackets--
+		case token.ASYNC:
+			if !state.asyncDef && !inc.version.AtLeast(Python37) {
+				state.asyncDef = true
+				state.asyncDefIndent = len(state.indentation) - 1
+			}
+		case token.NEWLINE:
+			if state.asyncDef {
+				state.asyncDefNewline = true
+			}
+			// The NEWLINE added at the end of the input is not followed by a line
+			if tok.Literal != "" {
+				checkpoints = append(checkpoints, checkpoint{
+					index:  i + 1,
+					offset: tok.OffsetEnd,
+					line:   tok.LineEnd + 1,
+					state:  state.copy(),
+				})
+			}
+		}
+	}
+	return checkpoints
+}
+
+// indentationColumns returns the column and alternate column of an INDENT literal
+func (scanner *Scanner) indentationColumns(literal string) (int, int) {
+	col := 0
+	altcol := 0
+	for _, ch := range literal {
+		if ch == '\t' {
+			col = (col/scanner.tabsize + 1) * scanner.tabsize
+			altcol = (altcol/scanner.tabsizeAlt + 1) * scanner.tabsizeAlt
+		} else {
+			col++
+			altcol++
+		}
+	}
+	return col, altcol
+}
+
+// lineState returns the state of a scanner which has just returned a NEWLINE
+func (scanner *Scanner) lineState() lineState {
+	depth := scanner.indentationCurrent + 1
+	state := lineState{
+		brackets:        scanner.indentationLevel,
+		indentation:     scanner.indentationStack[:depth],
+		indentationAlt:  scanner.indentationAltStack[:depth],
+		asyncDef:        scanner.asyncDef,
+		asyncDefIndent:  scanner.asyncDefIndent,
+		asyncDefNewline: scanner.asyncDefNewline,
+	}
+	return state.copy()
+}
+
+// resume moves the scanner to the line start of cp
+func (scanner *Scanner) resume(cp checkpoint) {
+	copy(scanner.indentationStack, cp.state.indentation)
+	copy(scanner.indentationAltStack, cp.state.indentationAlt)
+	scanner.indentationCurrent = len(cp.state.indentation) - 1
+	scanner.indentationLevel = cp.state.brackets
+	scanner.asyncDef = cp.state.asyncDef
+	scanner.asyncDefIndent = cp.state.asyncDefIndent
+	scanner.asyncDefNewline = cp.state.asyncDefNewline
+	scanner.currentOffset = cp.offset
+	scanner.currentLine = cp.line
+	scanner.currentColumn = 0
+	scanner.atBol = true
+}
@@ -0,0 +1,187 @@
+package scanner
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/brettlangdon/gython/token"
+)
+
+// scanWithError returns the tokens of source up to the ENDMARKER or ERRORTOKEN and the scanner's error
+func scanWithError(source string) ([]*token.Token, *ScanError) {
+	scanner := NewBytesScanner([]byte(source))
+	tokens := make([]*token.Token, 0)
+	for {
+		tok := scanner.NextToken()
+		tokens = append(tokens, tok)
+		if tok.ID == token.ENDMARKER || tok.ID == token.ERRORTOKEN {
+			return tokens, scanner.Err()
+		}
+	}
+}
+
+// diffEdit returns the edit which turns before into after, replacing the part between their common prefix and suffix
+func diffEdit(before string, after string) Edit {
+	prefix := 0
+	for prefix < len(before) && prefix < len(after) && before[prefix] == after[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(before)-prefix && suffix < len(after)-prefix && before[len(before)-1-suffix] == after[len(after)-1-suffix] {
+		suffix++
+	}
+	return Edit{Start: prefix, End: len(before) - suffix, Text: after[prefix : len(after)-suffix]}
+}
+
+// Each test is a buffer followed by the versions it is edited into, one after another
+var incrementalTests = [][]string{
+	{"x = 1\ny = 2\nz = 3\n", "x = 1\nyy = 22\nz = 3\n", "x = 1\nz = 3\n", "w = 0\nx = 1\nz = 3\n"},
+	// Indentation changes move the following INDENT and DEDENT tokens
+	{
+		"if a:\n    b\n    c\nd\n",
+		"if a:\n    b\n        c\nd\n",
+		"if a:\n    b\n    if c:\n        c\nd\n",
+		"if a:\n    b\nif c:\n        c\nd\n",
+		"if a:\n    b\n  c\nd\n",
+		"if a:\n\tb\n    c\nd\n",
+		"if a:\n    b\n    c\nd\n",
+	},
+	// Edits inside, opening and closing triple-quoted strings
+	{
+		"x = '''a\nb\nc'''\ny = 1\n",
+		"x = '''a\nbb\nc'''\ny = 1\n",
+		"x = '''a\nbb\n'''\nc'''\ny = 1\n",
+		"x = '''a\nbb\n'''\nc = '''\ny = 1\n",
+		"x = '''a\nbb\n'''\nc = '''\ny = 1'''\n",
+		"x = 1\nc = '''\ny = 1'''\n",
+		"x = 1\nc = 2\ny = 1\n",
+	},
+	// Continuation lines join lines, adding or removing one changes the logical lines after it
+	{
+		"x = 1 + \\\n    2\ny = 3\n",
+		"x = 1 + \\\n    2 + \\\n    3\ny = 3\n",
+		"x = 1 + \\\n    2 + \\\ny = 3\n",
+		"x = 1 + \\\n    2 + \\\n\ny = 3\n",
+		"x = 1 + \n    2\ny = 3\n",
+		"x = 1 + \\\n    2\ny = 3\n",
+	},
+	// Brackets keep lines in one logical line
+	{
+		"f(a,\n  b)\nif c:\n    d\n",
+		"f(a,\n  b\nif c:\n    d\n",
+		"f(a,\n  b\nif c:\n    d)\n",
+		"f(a,\n  b))\nif c:\n    d\n",
+		"f(a,\n  b)\nif c:\n    d\n",
+	},
+	// async and await are keywords only inside an async def
+	{
+		"async def f():\n    await x\nawait = 1\n",
+		"def f():\n    await x\nawait = 1\n",
+		"async def f():\n    await x\n    await = 1\n",
+		"async def f():\n    await x\n\nasync = await\n",
+	},
+	// The scanner ends at an error and picks up again once it is fixed
+	{
+		"x = 1\ny = 'a\nz = 3\n",
+		"x = 1\ny = 'a'\nz = 3\n",
+		"x = 1\ny = 'a'\n  z = 3\n",
+		"x = 1\ny = 'a'\nz = 3\n",
+	},
+	// Line endings and a byte order mark
+	{
+		"\xef\xbb\xbfx = 1\r\ny = 2\r\n",
+		"x = 1\r\ny = 2\r\n",
+		"x = 1\r\ny = 2\n",
+		"\xef\xbb\xbfx = 1\ry = 2\n",
+	},
+	{"x = 1", "x = 1\n", "", "x"},
+}
+
+func TestIncrementalUpdate(t *testing.T) {
+	for _, versions := range incrementalTests {
+		inc := NewIncrementalScanner([]byte(versions[0]), nil)
+		inc.Tokens()
+		for i, source := range versions[1:] {
+			edit := diffEdit(versions[i], source)
+			updated, err := inc.Update(edit)
+			if err != nil {
+				t.Errorf("Update(%#v) of %q returned an error: %s", edit, versions[i], err)
+				break
+			}
+			if inc.Source() != source {
+				t.Errorf("Update(%#v) of %q changed the source to %q, want %q", edit, versions[i], inc.Source(), source)
+				break
+			}
+
+			want, wantErr := scanWithError(source)
+			if got, want := tokenDetails(updated), tokenDetails(want); !reflect.DeepEqual(got, want) {
+				t.Errorf("Update(%#v) of %q returned\n%s\nwant\n%s", edit, versions[i], strings.Join(got, "\n"), strings.Join(want, "\n"))
+			}
+			if !reflect.DeepEqual(inc.Err(), wantErr) {
+				t.Errorf("Update(%#v) of %q has the error %v, want %v", edit, versions[i], inc.Err(), wantErr)
+			}
+		}
+	}
+}
+
+func TestIncrementalUpdateReusesTokens(t *testing.T) {
+	source := "if a:\n    b = 1\n" + strings.Repeat("c = [\n    1,\n]\n", 3)
+	inc := NewIncrementalScanner([]byte(source), nil)
+	before := inc.Tokens()
+	// Mark the last NEWLINE so a copy can be told apart from a token scanned again
+	before[len(before)-2].Prefix = "reused"
+
+	updated, err := inc.Update(diffEdit(source, strings.Replace(source, "b = 1", "b = (1 +\n 2)", 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	last, lastBefore := updated[len(updated)-2], before[len(before)-2]
+	if last.Prefix != "reused" || last.LineStart != lastBefore.LineStart+1 || last.OffsetStart != lastBefore.OffsetStart+7 {
+		t.Errorf("the last NEWLINE is %#v, it was %#v", last, lastBefore)
+	}
+
+}
+
+func TestIncrementalUpdateWarnings(t *testing.T) {
+	// The tab on line 3 is only consistent with line 2 when a tab is eight spaces
+	versions := []struct {
+		source string
+		// Line of the warning, 0 for none
+		line int
+	}{
+		{"x = 1\nif a:\n        b\n\tc\n", 4},
+		// An edit before the warning moves it, one after it keeps it
+		{"x = 1\n\ny = 2\nif a:\n        b\n\tc\n", 6},
+		{"x = 1\n\ny = 2\nif a:\n        b\n\tc\nd = 3\n", 6},
+		// Fixing the line removes it, breaking another line warns about that one
+		{"x = 1\n\ny = 2\nif a:\n        b\n        c\nd = 3\n", 0},
+		{"if z:\n        z\n\tz\n\ny = 2\nif a:\n        b\n        c\nd = 3\n", 3},
+		// Only the first one is warned about
+		{"if z:\n        z\n\tz\n\ny = 2\nif a:\n        b\n\tc\nd = 3\n", 3},
+		{"x = 1\n\ny = 2\nif a:\n        b\n\tc\nd = 3\n", 6},
+	}
+	inc := NewIncrementalScanner([]byte(versions[0].source), nil)
+	inc.SetTabCheck(TabCheckWarn)
+	inc.Tokens()
+	for i, version := range versions {
+		if i > 0 {
+			if _, err := inc.Update(diffEdit(versions[i-1].source, version.source)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		warnings := inc.Warnings()
+		if version.line == 0 && len(warnings) != 0 || version.line != 0 && (len(warnings) != 1 || warnings[0].Line != version.line) {
+			t.Errorf("after update %d to %q the warnings are %v, want one on line %d", i, version.source, warnings, version.line)
+		}
+	}
+}
+
+func TestIncrementalUpdateOutOfRange(t *testing.T) {
+	inc := NewIncrementalScanner([]byte("x = 1\n"), nil)
+	for _, edit := range []Edit{{Start: -1, End: 0}, {Start: 2, End: 1}, {Start: 0, End: 7}} {
+		if _, err := inc.Update(edit); err == nil {
+			t.Errorf("Update(%#v) did not return an error", edit)
+		}
+	}
+}
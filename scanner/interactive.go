@@ -0,0 +1,71 @@
+package scanner
+
+import (
+	"io"
+
+	"github.com/brettlangdon/gython/errorcode"
+)
+
+// Prompt is called by an interactive scanner whenever it needs another line of
+// input. prompt is ps1 at the start of a statement and ps2 on continuation lines.
+// The line should include its trailing newline, an empty line or io.EOF ends the
+// input and any other error interrupts scanning.
+type Prompt func(prompt string) (string, error)
+
+// NewInteractiveScanner returns a Scanner which reads its source one line at a
+// time from prompt. Like CPython's interactive mode a totally empty line ends
+// a compound statement.
+func NewInteractiveScanner(prompt Prompt, ps1 string, ps2 string) *Scanner {
+	scanner := NewBytesScanner(nil)
+	scanner.prompt = prompt
+	scanner.ps1 = ps1
+	scanner.ps2 = ps2
+	scanner.promptNext = ps1
+	return scanner
+}
+
+// Reset discards the rest of the current interactive statement along with the
+// indentation, bracket and error state. The next token starts a new statement,
+// read with ps1 and numbered from line 1.
+func (scanner *Scanner) Reset() {
+	scanner.source = ""
+	scanner.currentOffset = 0
+	scanner.currentLine = 1
+	scanner.currentColumn = 0
+	scanner.atBol = true
+	scanner.lineStarted = false
+	scanner.indentationCurrent = 0
+	scanner.indentationLevel = 0
+	scanner.indentationPending = 0
+	scanner.asyncDef = false
+	scanner.asyncDefIndent = 0
+	scanner.asyncDefNewline = false
+	scanner.tokenBuffer = scanner.tokenBuffer[:0]
+	scanner.state = errorcode.E_OK
+	scanner.err = nil
+	scanner.promptNext = scanner.ps1
+}
+
+// readLine appends the next line of interactive input to the source. It
+// returns false when there is no more input.
+func (scanner *Scanner) readLine() bool {
+	switch scanner.state {
+	case errorcode.E_EOF, errorcode.E_INTR:
+		return false
+	}
+	if scanner.prompt == nil {
+		return false
+	}
+
+	line, err := scanner.prompt(scanner.promptNext)
+	scanner.promptNext = scanner.ps2
+	if err != nil && err != io.EOF {
+		scanner.state = errorcode.E_INTR
+		return false
+	}
+	if line == "" {
+		return false
+	}
+	scanner.source += line
+	return true
+}
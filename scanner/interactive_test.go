@@ -0,0 +1,94 @@
+package scanner
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/brettlangdon/gython/errorcode"
+	"github.com/brettlangdon/gython/token"
+)
+
+// interactiveInput returns a Prompt answering with lines one at a time, and the prompts it was called with
+func interactiveInput(lines ...string) (Prompt, *[]string) {
+	prompts := make([]string, 0)
+	prompt := func(prompt string) (string, error) {
+		prompts = append(prompts, prompt)
+		if len(lines) == 0 {
+			return "", nil
+		}
+		line := lines[0]
+		lines = lines[1:]
+		if line == "^C" {
+			return "", errors.New("interrupted")
+		}
+		return line, nil
+	}
+	return prompt, &prompts
+}
+
+// scanTokens returns the line and ID of the next count tokens
+func scanTokens(scanner *Scanner, count int) []string {
+	tokens := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		tok := scanner.NextToken()
+		tokens = append(tokens, fmt.Sprintf("%d %s", tok.LineStart, tok))
+	}
+	return tokens
+}
+
+func TestInteractivePrompts(t *testing.T) {
+	prompt, prompts := interactiveInput("x = (1,\n", "2)\n", "y\n", "if a:\n", "    b\n", "\n", "^C", "z\n")
+	scanner := NewInteractiveScanner(prompt, ">>> ", "... ")
+
+	tests := []struct {
+		tokens  []string
+		prompts []string
+	}{
+		// ps1 starts the statement, ps2 reads the lines it continues on
+		{
+			[]string{"1 NAME", "1 EQUAL", "1 LPAR", "1 NUMBER", "1 COMMA", "2 NUMBER", "2 RPAR", "2 NEWLINE"},
+			[]string{">>> ", "... "},
+		},
+		// Without a Reset the scanner is still in the same input and keeps prompting with ps2
+		{
+			[]string{"3 NAME", "3 NEWLINE"},
+			[]string{">>> ", "... ", "... "},
+		},
+		// After a Reset the next statement is read with ps1 and numbered from line 1, an empty line ends the block
+		{
+			[]string{"1 NAME", "1 NAME", "1 COLON", "1 NEWLINE", "2 INDENT", "2 NAME", "2 NEWLINE", "3 DEDENT", "3 NEWLINE"},
+			[]string{">>> ", "... ", "... ", ">>> ", "... ", "... "},
+		},
+		// An error from the prompt interrupts scanning
+		{
+			[]string{"1 <ERRORTOKEN>"},
+			[]string{">>> ", "... ", "... ", ">>> ", "... ", "... ", ">>> "},
+		},
+		{
+			[]string{"1 NAME", "1 NEWLINE"},
+			[]string{">>> ", "... ", "... ", ">>> ", "... ", "... ", ">>> ", ">>> "},
+		},
+	}
+	for i, test := range tests {
+		if i >= 2 {
+			scanner.Reset()
+		}
+		if tokens := scanTokens(scanner, len(test.tokens)); !reflect.DeepEqual(tokens, test.tokens) {
+			t.Errorf("statement %d scanned as %v, want %v", i, tokens, test.tokens)
+		}
+		if !reflect.DeepEqual(*prompts, test.prompts) {
+			t.Errorf("after statement %d the prompts were %q, want %q", i, *prompts, test.prompts)
+		}
+		if i == 3 {
+			if err := scanner.Err(); err == nil || err.Code != errorcode.E_INTR || err.Type() != "KeyboardInterrupt" {
+				t.Errorf("an interrupted prompt returned the error %v", err)
+			}
+		}
+	}
+
+	if tok := scanner.NextToken(); tok.ID != token.ENDMARKER {
+		t.Errorf("the end of the input returned %s, want ENDMARKER", tok)
+	}
+}
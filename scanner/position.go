@@ -1,66 +1,133 @@
 package scanner
 
 import (
+	"strings"
+	"unicode/utf8"
+
 	"github.com/brettlangdon/gython/token"
 )
 
 type Position struct {
+	Offset int
 	Line   int
 	Column int
 	Char   rune
 }
 
+// tokenSlabSize is the number of tokens allocated together by a tokenSlab
+const tokenSlabSize = 128
+
+// tokenSlab hands out tokens from a shared backing array, so scanning does
+// not need an allocation for every token produced
+type tokenSlab struct {
+	tokens []token.Token
+}
+
+func (slab *tokenSlab) next() *token.Token {
+	if len(slab.tokens) == 0 {
+		slab.tokens = make([]token.Token, tokenSlabSize)
+	}
+	tok := &slab.tokens[0]
+	slab.tokens = slab.tokens[1:]
+	return tok
+}
+
+// Positions is the contiguous run of source positions which make up a token
 type Positions struct {
-	positions []*Position
+	// source is shared with the scanner, which may append to it while scanning interactively
+	source *string
+	slab   *tokenSlab
+	start  Position
+	last   Position
+	length int
+}
+
+func NewPositions(source string) Positions {
+	return Positions{
+		source: &source,
+		slab:   &tokenSlab{},
+	}
 }
 
-func NewPositions() *Positions {
-	return &Positions{
-		positions: make([]*Position, 0),
+func (positions *Positions) Append(pos Position) {
+	if positions.length == 0 {
+		positions.start = pos
 	}
+	positions.last = pos
+	positions.length++
+}
+
+func (positions *Positions) Start() Position {
+	return positions.start
 }
 
-func (positions *Positions) Append(pos *Position) {
-	positions.positions = append(positions.positions, pos)
+func (positions *Positions) Length() int {
+	return positions.length
 }
 
 func (positions *Positions) StartingLine() int {
-	return positions.positions[0].Line
+	return positions.start.Line
 }
 
 func (positions *Positions) EndingLine() int {
-	last := len(positions.positions) - 1
-	return positions.positions[last].Line
+	return positions.last.Line
 }
 
 func (positions *Positions) StartingColumn() int {
-	return positions.positions[0].Column
+	return positions.start.Column
 }
 
+// EndingColumn returns the byte column just past the last position
 func (positions *Positions) EndingColumn() int {
-	last := len(positions.positions) - 1
-	return positions.positions[last].Column
+	return positions.last.Column + positions.lastWidth()
 }
 
-func (positions *Positions) String() string {
-	literal := ""
-	for _, pos := range positions.positions {
-		if pos.Char == EOF {
-			continue
-		}
-		literal += string(pos.Char)
+func (positions *Positions) StartingOffset() int {
+	return positions.start.Offset
+}
+
+// EndingOffset returns the byte offset just past the last position
+func (positions *Positions) EndingOffset() int {
+	return positions.last.Offset + positions.lastWidth()
+}
+
+// lastWidth returns the number of bytes used by the last position, which is 0 at the end of the source
+func (positions *Positions) lastWidth() int {
+	rest := (*positions.source)[positions.last.Offset:]
+	if strings.HasPrefix(rest, "\r") {
+		return newlineWidth(rest)
 	}
+	_, width := utf8.DecodeRuneInString(rest)
+	return width
+}
 
-	return literal
+// newlineWidth returns the number of bytes of the line ending starting with \r at the start of s
+func newlineWidth(s string) int {
+	if strings.HasPrefix(s, "\r\n") {
+		return 2
+	}
+	return 1
+}
+
+// String returns the source text covered by the positions
+func (positions *Positions) String() string {
+	if positions.length == 0 {
+		return ""
+	}
+	return (*positions.source)[positions.StartingOffset():positions.EndingOffset()]
 }
 
 func (positions *Positions) AsToken(id token.TokenID) *token.Token {
-	return &token.Token{
+	tok := positions.slab.next()
+	*tok = token.Token{
 		ID:          id,
 		LineStart:   positions.StartingLine(),
 		ColumnStart: positions.StartingColumn(),
+		OffsetStart: positions.StartingOffset(),
 		LineEnd:     positions.EndingLine(),
 		ColumnEnd:   positions.EndingColumn(),
+		OffsetEnd:   positions.EndingOffset(),
 		Literal:     positions.String(),
 	}
+	return tok
 }
@@ -1,8 +1,9 @@
 package scanner
 
 import (
-	"bufio"
 	"io"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/brettlangdon/gython/errorcode"
 	"github.com/brettlangdon/gython/token"
@@ -11,173 +12,445 @@ import (
 var EOF rune = 0
 var MAXINDENT int = 100
 
+// MAXLEVEL limits how deeply brackets nest, like the tokenizer of later CPython versions,
+// so the recursive parser and lowering of a nested expression can't run out of stack
+var MAXLEVEL int = 200
+
+// utf8BOM is the byte order mark a UTF-8 source may start with, it is skipped like in CPython
+const utf8BOM = "\xef\xbb\xbf"
+
+// sourceStart returns the offset of the first character of source, after any byte order mark
+func sourceStart(source string) int {
+	if strings.HasPrefix(source, utf8BOM) {
+		return len(utf8BOM)
+	}
+	return 0
+}
+
 type Scanner struct {
 	asyncDef            bool
+	asyncDefIndent      int
+	asyncDefNewline     bool
 	atBol               bool
 	currentColumn       int
 	currentLine         int
-	currentPosition     *Position
+	currentOffset       int
+	currentPosition     Position
+	err                 *ScanError
 	indentationAltStack []int
 	indentationCurrent  int
 	indentationLevel    int
 	indentationPending  int
 	indentationStack    []int
-	positionBuffer      []*Position
+	lineStarted         bool
+	prompt              Prompt
+	readErr             error
+	ps1                 string
+	ps2                 string
+	promptNext          string
+	source              string
+	tokenSlab           tokenSlab
 	tokenBuffer         []*token.Token
-	reader              *bufio.Reader
 	state               errorcode.ErrorCode
+	tabCheck            TabCheck
 	tabsize             int
 	tabsizeAlt          int
+	version             Version
+	warnings            []*ScanError
 }
 
+// NewScanner reads all of r into memory and returns a Scanner for it.
+// If reading fails the Scanner's first token is an ERRORTOKEN whose Err is the read error.
 func NewScanner(r io.Reader) *Scanner {
+	// Reading into a Builder lets the source become a string without copying it
+	var source strings.Builder
+	if _, err := io.Copy(&source, r); err != nil {
+		scanner := NewStringScanner("")
+		scanner.readErr = err
+		return scanner
+	}
+	return NewStringScanner(source.String())
+}
+
+// NewBytesScanner returns a Scanner for the Python source in source
+func NewBytesScanner(source []byte) *Scanner {
+	return NewStringScanner(string(source))
+}
+
+// NewStringScanner returns a Scanner for the Python source in source, without copying it
+func NewStringScanner(source string) *Scanner {
 	return &Scanner{
 		atBol:               true,
 		currentColumn:       0,
 		currentLine:         1,
+		currentOffset:       sourceStart(source),
 		indentationAltStack: make([]int, MAXINDENT),
 		indentationCurrent:  0,
 		indentationLevel:    0,
 		indentationPending:  0,
 		indentationStack:    make([]int, MAXINDENT),
-		positionBuffer:      make([]*Position, 0),
+		source:              source,
 		tokenBuffer:         make([]*token.Token, 0),
-		reader:              bufio.NewReader(r),
 		state:               errorcode.E_OK,
+		tabCheck:            TabCheckError,
 		tabsize:             8,
+		tabsizeAlt:          1,
+		version:             DefaultVersion,
+		warnings:            make([]*ScanError, 0),
 	}
 }
 
+// SetTabCheck configures whether inconsistent tabs and spaces are ignored, warned about or an error
+func (scanner *Scanner) SetTabCheck(check TabCheck) {
+	scanner.tabCheck = check
+}
+
+// SetVersion selects which Python version's syntax the scanner accepts
+func (scanner *Scanner) SetVersion(version Version) {
+	scanner.version = version
+}
+
+// Version returns the Python version whose syntax the scanner accepts
+func (scanner *Scanner) Version() Version {
+	return scanner.version
+}
+
+// Warnings returns the non-fatal diagnostics collected while scanning
+func (scanner *Scanner) Warnings() []*ScanError {
+	return scanner.warnings
+}
+
 func (scanner *Scanner) State() errorcode.ErrorCode {
 	return scanner.state
 }
 
-func (scanner *Scanner) nextPosition() *Position {
-	if len(scanner.positionBuffer) > 0 {
-		last := len(scanner.positionBuffer) - 1
-		scanner.currentPosition = scanner.positionBuffer[last]
-		scanner.positionBuffer = scanner.positionBuffer[0:last]
-		return scanner.currentPosition
+// Err returns the error which caused the last ERRORTOKEN, or nil
+func (scanner *Scanner) Err() *ScanError {
+	return scanner.err
+}
+
+// indentationError reports whether inconsistent tabs and spaces at pos should stop the scanner
+func (scanner *Scanner) indentationError(pos Position) bool {
+	switch scanner.tabCheck {
+	case TabCheckError:
+		return true
+	case TabCheckWarn:
+		scanner.warnings = append(scanner.warnings, NewScanError(errorcode.E_TABSPACE, pos.Line, pos.Column))
+		// Only warn about the first occurrence, like CPython
+		scanner.tabCheck = TabCheckOff
 	}
+	return false
+}
 
-	next, _, err := scanner.reader.ReadRune()
-	if err != nil {
-		scanner.state = errorcode.E_EOF
-		next = EOF
-		scanner.currentLine++
-		scanner.currentColumn = 0
+func (scanner *Scanner) errorToken(code errorcode.ErrorCode, pos Position) *token.Token {
+	return scanner.errorTokenWithMessage(code, pos, code.Message())
+}
+
+func (scanner *Scanner) featureErrorToken(feature string, required Version, pos Position) *token.Token {
+	return scanner.errorTokenWithMessage(errorcode.E_SYNTAX, pos, FeatureMessage(feature, required))
+}
+
+func (scanner *Scanner) errorTokenWithMessage(code errorcode.ErrorCode, pos Position, msg string) *token.Token {
+	scanner.state = code
+	scanner.err = NewScanError(code, pos.Line, pos.Column)
+	scanner.err.Message = msg
+	return &token.Token{
+		ID:          token.ERRORTOKEN,
+		LineStart:   pos.Line,
+		ColumnStart: pos.Column,
+		OffsetStart: pos.Offset,
+		LineEnd:     pos.Line,
+		ColumnEnd:   pos.Column,
+		OffsetEnd:   pos.Offset,
+		Literal:     "",
 	}
+}
 
-	if next == '\n' || next == EOF {
-		scanner.currentLine++
-		scanner.currentColumn = 0
+// indentationToken returns an INDENT or DEDENT for the line starting at the current position.
+// An INDENT spans the line's indentation, a DEDENT is empty and sits at the first token.
+func (scanner *Scanner) indentationToken(id token.TokenID) *token.Token {
+	pos := scanner.currentPosition
+	tok := scanner.tokenSlab.next()
+	*tok = token.Token{
+		ID:          id,
+		LineStart:   pos.Line,
+		ColumnStart: pos.Column,
+		OffsetStart: pos.Offset,
+		LineEnd:     pos.Line,
+		ColumnEnd:   pos.Column,
+		OffsetEnd:   pos.Offset,
+		Literal:     "",
+	}
+	if id == token.INDENT {
+		tok.ColumnStart = 0
+		tok.OffsetStart = pos.Offset - pos.Column
+		tok.Literal = scanner.source[tok.OffsetStart:tok.OffsetEnd]
 	}
+	return tok
+}
 
-	pos := &Position{
-		Char:   next,
+// newlineAtEOF returns the NEWLINE ending a last line which has no newline character
+func (scanner *Scanner) newlineAtEOF() *token.Token {
+	return &token.Token{
+		ID:          token.NEWLINE,
+		LineStart:   scanner.currentLine,
+		ColumnStart: scanner.currentColumn,
+		OffsetStart: scanner.currentOffset,
+		LineEnd:     scanner.currentLine,
+		ColumnEnd:   scanner.currentColumn + 1,
+		OffsetEnd:   scanner.currentOffset,
+		Literal:     "",
+	}
+}
+
+func (scanner *Scanner) nextPosition() Position {
+	pos := Position{
+		Offset: scanner.currentOffset,
 		Line:   scanner.currentLine,
 		Column: scanner.currentColumn,
 	}
-	scanner.currentColumn++
+
+	if scanner.currentOffset >= len(scanner.source) && !scanner.readLine() {
+		if scanner.state != errorcode.E_INTR {
+			scanner.state = errorcode.E_EOF
+		}
+		pos.Char = EOF
+		if pos.Column > 0 {
+			// Input without a trailing newline ends as if it had one
+			pos.Line++
+			pos.Column = 0
+		}
+		scanner.currentPosition = pos
+		return pos
+	}
+
+	next, width := rune(scanner.source[scanner.currentOffset]), 1
+	if next >= utf8.RuneSelf {
+		next, width = utf8.DecodeRuneInString(scanner.source[scanner.currentOffset:])
+	} else if next == '\r' {
+		// Universal newlines, \r\n and a lone \r end a line like \n
+		next, width = '\n', newlineWidth(scanner.source[scanner.currentOffset:])
+	}
+	pos.Char = next
+	scanner.currentOffset += width
+	if next == '\n' {
+		scanner.currentLine++
+		scanner.currentColumn = 0
+	} else {
+		// Columns count bytes, like CPython
+		scanner.currentColumn += width
+	}
+	scanner.currentPosition = pos
 	return pos
 }
 
-func (scanner *Scanner) unreadPosition(pos *Position) {
-	scanner.positionBuffer = append(scanner.positionBuffer, pos)
+func (scanner *Scanner) newPositions() Positions {
+	return Positions{
+		source: &scanner.source,
+		slab:   &scanner.tokenSlab,
+	}
+}
+
+// unreadPosition rewinds the scanner so pos is the next position read
+func (scanner *Scanner) unreadPosition(pos Position) {
+	if pos.Char == EOF {
+		// Reading EOF never advanced the scanner
+		return
+	}
+	scanner.currentOffset = pos.Offset
+	scanner.currentLine = pos.Line
+	scanner.currentColumn = pos.Column
 }
 
-func (scanner *Scanner) parseNumber(positions *Positions, nextChar rune) *token.Token {
+func (scanner *Scanner) parseNumber(positions *Positions) *token.Token {
+	var errTok *token.Token
 	pos := scanner.nextPosition()
-	switch ch := pos.Char; {
-	case nextChar == '0' && (ch == 'j' || ch == 'J'):
-		// Imaginary
-		positions.Append(pos)
-	case nextChar == '0' && (ch == 'x' || ch == 'X'):
+	if positions.Start().Char != '0' {
+		// Decimal
+		if pos, errTok = scanner.digitTail(positions, pos, IsDigit); errTok != nil {
+			return errTok
+		}
+		return scanner.parseNumberSuffix(positions, pos)
+	}
+
+	switch pos.Char {
+	case 'x', 'X':
 		// Hex
 		positions.Append(pos)
-		pos = scanner.nextPosition()
-		if !IsXDigit(pos.Char) {
-			return positions.AsToken(token.ERRORTOKEN)
-		}
-		for IsXDigit(pos.Char) {
+		pos, errTok = scanner.radixTail(positions, IsXDigit)
+	case 'o', 'O':
+		// Octal
+		positions.Append(pos)
+		pos, errTok = scanner.radixTail(positions, IsOctDigit)
+	case 'b', 'B':
+		// Binary
+		positions.Append(pos)
+		pos, errTok = scanner.radixTail(positions, IsBinDigit)
+	default:
+		// Any number of zeros is allowed, old-style octal (e.g. 012) is not
+		for pos.Char == '0' || pos.Char == '_' {
+			if pos.Char == '_' {
+				next, ok, errTok := scanner.numberUnderscore(positions, pos, IsDigit)
+				if errTok != nil {
+					return errTok
+				} else if !ok {
+					break
+				}
+				pos = next
+				continue
+			}
 			positions.Append(pos)
 			pos = scanner.nextPosition()
 		}
-		scanner.unreadPosition(pos)
-	case nextChar == '0' && (ch == 'b' || ch == 'B'):
-		// Binary
+		nonzero := false
+		if IsDigit(pos.Char) {
+			nonzero = true
+			if pos, errTok = scanner.digitTail(positions, pos, IsDigit); errTok != nil {
+				return errTok
+			}
+		}
+		switch pos.Char {
+		case '.', 'e', 'E', 'j', 'J':
+			return scanner.parseNumberSuffix(positions, pos)
+		}
+		if nonzero {
+			return scanner.errorToken(errorcode.E_TOKEN, positions.Start())
+		}
+	}
+	if errTok != nil {
+		return errTok
+	}
+
+	scanner.unreadPosition(pos)
+	return positions.AsToken(token.NUMBER)
+}
+
+// parseNumberSuffix parses the optional fraction, exponent and imaginary parts of a decimal number
+func (scanner *Scanner) parseNumberSuffix(positions *Positions, pos Position) *token.Token {
+	if pos.Char == '.' {
 		positions.Append(pos)
 		pos = scanner.nextPosition()
-		if pos.Char != '0' && pos.Char != '1' {
-			return positions.AsToken(token.ERRORTOKEN)
+		if IsDigit(pos.Char) {
+			return scanner.parseFraction(positions, pos)
 		}
-		for pos.Char == '0' || pos.Char == '1' {
-			positions.Append(pos)
+	}
+	return scanner.parseExponent(positions, pos)
+}
+
+// parseFraction parses the digits after a decimal point, starting with pos
+func (scanner *Scanner) parseFraction(positions *Positions, pos Position) *token.Token {
+	pos, errTok := scanner.digitTail(positions, pos, IsDigit)
+	if errTok != nil {
+		return errTok
+	}
+	return scanner.parseExponent(positions, pos)
+}
+
+func (scanner *Scanner) parseExponent(positions *Positions, pos Position) *token.Token {
+	var errTok *token.Token
+	if pos.Char == 'e' || pos.Char == 'E' {
+		exponent := pos
+		sign := pos
+		pos = scanner.nextPosition()
+		if pos.Char == '-' || pos.Char == '+' {
+			sign = pos
 			pos = scanner.nextPosition()
+			if !IsDigit(pos.Char) {
+				return scanner.errorToken(errorcode.E_TOKEN, pos)
+			}
+		} else if !IsDigit(pos.Char) {
+			// The "e" is not part of this number
+			scanner.unreadPosition(pos)
+			scanner.unreadPosition(exponent)
+			return positions.AsToken(token.NUMBER)
 		}
-		scanner.unreadPosition(pos)
-	case nextChar == '0' && (ch == 'o' || ch == 'O'):
-		// Octal
+		positions.Append(exponent)
+		if sign != exponent {
+			positions.Append(sign)
+		}
+		if pos, errTok = scanner.digitTail(positions, pos, IsDigit); errTok != nil {
+			return errTok
+		}
+	}
+	if pos.Char == 'j' || pos.Char == 'J' {
+		// Imaginary
 		positions.Append(pos)
 		pos = scanner.nextPosition()
-		if pos.Char < '0' || pos.Char >= '8' {
-			return positions.AsToken(token.ERRORTOKEN)
+	}
+	scanner.unreadPosition(pos)
+	return positions.AsToken(token.NUMBER)
+}
+
+// radixTail parses the digits following a 0x, 0o or 0b prefix
+func (scanner *Scanner) radixTail(positions *Positions, valid func(rune) bool) (Position, *token.Token) {
+	pos := scanner.nextPosition()
+	if pos.Char == '_' {
+		next, ok, errTok := scanner.numberUnderscore(positions, pos, valid)
+		if errTok != nil {
+			return next, errTok
+		} else if !ok {
+			return pos, scanner.errorToken(errorcode.E_TOKEN, pos)
 		}
-		for pos.Char >= '0' && pos.Char < '8' {
+		pos = next
+	}
+	if !valid(pos.Char) {
+		return pos, scanner.errorToken(errorcode.E_TOKEN, pos)
+	}
+	return scanner.digitTail(positions, pos, valid)
+}
+
+// digitTail consumes the run of digits starting at pos, returning the first position after them
+func (scanner *Scanner) digitTail(positions *Positions, pos Position, valid func(rune) bool) (Position, *token.Token) {
+	for {
+		for valid(pos.Char) {
 			positions.Append(pos)
 			pos = scanner.nextPosition()
 		}
-		scanner.unreadPosition(pos)
-	default:
-		decimal := nextChar == '.'
-		imaginary := false
-		exponent := false
-		for {
-			if pos.Char == '.' && decimal {
-				break
-			} else if pos.Char == '.' && !decimal {
-				decimal = true
-			} else if (pos.Char == 'j' || pos.Char == 'J') && !imaginary {
-				imaginary = true
-			} else if (pos.Char == 'e' || pos.Char == 'E') && !exponent {
-				exponent = true
-				positions.Append(pos)
-				pos2 := scanner.nextPosition()
-				if pos2.Char == '-' || pos2.Char == '+' {
-					pos3 := scanner.nextPosition()
-					if !IsDigit(pos3.Char) {
-						return positions.AsToken(token.ERRORTOKEN)
-					}
-					scanner.unreadPosition(pos3)
-					positions.Append(pos2)
-				} else if !IsDigit(pos2.Char) {
-					return positions.AsToken(token.ERRORTOKEN)
-				} else {
-					scanner.unreadPosition(pos2)
-				}
-				pos = scanner.nextPosition()
-				continue
-			} else if !IsDigit(pos.Char) {
-				break
-			}
-			positions.Append(pos)
-			pos = scanner.nextPosition()
+		if pos.Char != '_' {
+			return pos, nil
 		}
-		scanner.unreadPosition(pos)
+		next, ok, errTok := scanner.numberUnderscore(positions, pos, valid)
+		if errTok != nil {
+			return next, errTok
+		} else if !ok {
+			return pos, nil
+		}
+		pos = next
 	}
+}
 
-	return positions.AsToken(token.NUMBER)
+// numberUnderscore handles a "_" at pos inside a numeric literal. It returns the
+// digit following the underscore, or false when the underscore ends the literal.
+func (scanner *Scanner) numberUnderscore(positions *Positions, pos Position, valid func(rune) bool) (Position, bool, *token.Token) {
+	next := scanner.nextPosition()
+	if !valid(next.Char) {
+		if scanner.version.AtLeast(Python36) {
+			return next, false, scanner.errorToken(errorcode.E_TOKEN, next)
+		}
+		// Before Python 3.6 the underscore starts the next NAME token
+		scanner.unreadPosition(next)
+		return pos, false, nil
+	}
+	if !scanner.version.AtLeast(Python36) {
+		return pos, false, scanner.featureErrorToken("numeric literal underscore", Python36, pos)
+	}
+	positions.Append(pos)
+	return next, true, nil
 }
 
-func (scanner *Scanner) parseQuoted(positions *Positions, quote rune) *token.Token {
+func (scanner *Scanner) parseQuoted(positions *Positions, prefix string, quote rune) *token.Token {
+	raw := strings.ContainsAny(prefix, "rR")
+	bytes := strings.ContainsAny(prefix, "bB")
+
 	// Determine quote size, 1 or 3 (e.g. 'string',  '''string''')
 	quoteSize := 1
 	endQuoteSize := 0
+	start := positions.Start()
 	pos := scanner.nextPosition()
 	if pos.Char == quote {
+		positions.Append(pos)
 		pos2 := scanner.nextPosition()
 		if pos2.Char == quote {
-			positions.Append(pos)
 			positions.Append(pos2)
 			quoteSize = 3
 		} else {
@@ -187,6 +460,8 @@ func (scanner *Scanner) parseQuoted(positions *Positions, quote rune) *token.Tok
 	} else {
 		scanner.unreadPosition(pos)
 	}
+	// Escape offsets are byte positions within the body, like the columns of tokens
+	bodyStart := positions.EndingOffset()
 
 	for {
 		if endQuoteSize == quoteSize {
@@ -195,21 +470,44 @@ func (scanner *Scanner) parseQuoted(positions *Positions, quote rune) *token.Tok
 		pos = scanner.nextPosition()
 		positions.Append(pos)
 		if pos.Char == EOF {
-			return positions.AsToken(token.ERRORTOKEN)
+			if quoteSize == 3 {
+				return scanner.errorToken(errorcode.E_EOFS, start)
+			}
+			return scanner.errorToken(errorcode.E_EOLS, pos)
 		}
 		if quoteSize == 1 && pos.Char == '\n' {
-			return positions.AsToken(token.ERRORTOKEN)
+			return scanner.errorToken(errorcode.E_EOLS, pos)
+		}
+		if bytes && pos.Char >= 128 {
+			return scanner.errorTokenWithMessage(errorcode.E_TOKEN, start, "bytes can only contain ASCII literal characters.")
 		}
 		if pos.Char == quote {
 			endQuoteSize += 1
-		} else {
-			endQuoteSize = 0
-			if pos.Char == '\\' {
-				pos = scanner.nextPosition()
+			continue
+		}
+		endQuoteSize = 0
+		if pos.Char != '\\' {
+			continue
+		}
+
+		offset := pos.Offset - bodyStart
+		escape := scanner.nextPosition()
+		if escape.Char == EOF {
+			// Let the next iteration report the unterminated string
+			scanner.unreadPosition(escape)
+			continue
+		}
+		positions.Append(escape)
+		if !raw {
+			if msg := scanner.validateEscape(positions, escape.Char, bytes, offset); msg != "" {
+				return scanner.errorTokenWithMessage(errorcode.E_TOKEN, start, msg)
 			}
 		}
 	}
-	return positions.AsToken(token.STRING)
+
+	tok := positions.AsToken(token.STRING)
+	tok.Prefix = prefix
+	return tok
 }
 
 func (scanner *Scanner) unreadToken(tok *token.Token) {
@@ -217,6 +515,21 @@ func (scanner *Scanner) unreadToken(tok *token.Token) {
 }
 
 func (scanner *Scanner) NextToken() *token.Token {
+	tok := scanner.nextToken()
+	switch tok.ID {
+	case token.NEWLINE:
+		scanner.lineStarted = false
+	case token.INDENT, token.DEDENT, token.ENDMARKER, token.ERRORTOKEN:
+	default:
+		scanner.lineStarted = true
+	}
+	return tok
+}
+
+func (scanner *Scanner) nextToken() *token.Token {
+	if scanner.readErr != nil {
+		return scanner.errorTokenWithMessage(errorcode.E_ERROR, Position{Line: 1}, scanner.readErr.Error())
+	}
 next_line:
 	if len(scanner.tokenBuffer) > 0 {
 		last := len(scanner.tokenBuffer) - 1
@@ -226,8 +539,8 @@ next_line:
 	}
 
 	blankline := false
-	positions := NewPositions()
-	var pos *Position
+	positions := scanner.newPositions()
+	var pos Position
 
 	if scanner.atBol {
 		// Get indentation level
@@ -242,6 +555,10 @@ next_line:
 			} else if pos.Char == '\t' {
 				col = (col/scanner.tabsize + 1) * scanner.tabsize
 				altcol = (altcol/scanner.tabsizeAlt + 1) * scanner.tabsizeAlt
+			} else if pos.Char == '\f' {
+				// Control-L (formfeed) resets the column
+				col = 0
+				altcol = 0
 			} else {
 				break
 			}
@@ -249,10 +566,13 @@ next_line:
 		}
 		scanner.unreadPosition(pos)
 
-		if pos.Char == '#' || pos.Char == '\n' {
-			// Lines with only newline or comment, shouldn't affect indentation
-			// TODO: Handle prompt
-			if col == 0 && pos.Char == '\n' && false {
+		if pos.Char == EOF {
+			// The end of the input closes every open block
+			col, altcol = 0, 0
+		} else if pos.Char == '#' || pos.Char == '\n' {
+			// Lines with only newline or comment, shouldn't affect indentation,
+			// except totally empty lines which end a compound statement when interactive
+			if col == 0 && pos.Char == '\n' && scanner.prompt != nil {
 				blankline = false
 			} else {
 				blankline = true
@@ -261,38 +581,17 @@ next_line:
 		if !blankline && scanner.indentationLevel == 0 {
 			if col == scanner.indentationStack[scanner.indentationCurrent] {
 				if altcol != scanner.indentationAltStack[scanner.indentationCurrent] {
-					pos = scanner.currentPosition
-					return &token.Token{
-						ID:          token.ERRORTOKEN,
-						LineStart:   pos.Line,
-						ColumnStart: pos.Column,
-						LineEnd:     pos.Line,
-						ColumnEnd:   pos.Column,
-						Literal:     "",
+					if scanner.indentationError(pos) {
+						return scanner.errorToken(errorcode.E_TABSPACE, pos)
 					}
 				}
 			} else if col > scanner.indentationStack[scanner.indentationCurrent] {
 				if scanner.indentationCurrent+1 >= MAXINDENT {
-					scanner.state = errorcode.E_TOODEEP
-					pos = scanner.currentPosition
-					return &token.Token{
-						ID:          token.ERRORTOKEN,
-						LineStart:   pos.Line,
-						ColumnStart: pos.Column,
-						LineEnd:     pos.Line,
-						ColumnEnd:   pos.Column,
-						Literal:     "",
-					}
+					return scanner.errorToken(errorcode.E_TOODEEP, pos)
 				}
 				if altcol <= scanner.indentationAltStack[scanner.indentationCurrent] {
-					pos = scanner.currentPosition
-					return &token.Token{
-						ID:          token.ERRORTOKEN,
-						LineStart:   pos.Line,
-						ColumnStart: pos.Column,
-						LineEnd:     pos.Line,
-						ColumnEnd:   pos.Column,
-						Literal:     "",
+					if scanner.indentationError(pos) {
+						return scanner.errorToken(errorcode.E_TABSPACE, pos)
 					}
 				}
 				scanner.indentationPending++
@@ -306,27 +605,11 @@ next_line:
 					scanner.indentationCurrent--
 				}
 				if col != scanner.indentationStack[scanner.indentationCurrent] {
-					scanner.state = errorcode.E_DEDENT
-					pos = scanner.currentPosition
-					return &token.Token{
-						ID:          token.ERRORTOKEN,
-						LineStart:   pos.Line,
-						ColumnStart: pos.Column,
-						LineEnd:     pos.Line,
-						ColumnEnd:   pos.Column,
-						Literal:     "",
-					}
+					return scanner.errorToken(errorcode.E_DEDENT, pos)
 				}
 				if altcol != scanner.indentationAltStack[scanner.indentationCurrent] {
-					scanner.state = errorcode.E_DEDENT
-					pos = scanner.currentPosition
-					return &token.Token{
-						ID:          token.ERRORTOKEN,
-						LineStart:   pos.Line,
-						ColumnStart: pos.Column,
-						LineEnd:     pos.Line,
-						ColumnEnd:   pos.Column,
-						Literal:     "",
+					if scanner.indentationError(pos) {
+						return scanner.errorToken(errorcode.E_TABSPACE, pos)
 					}
 				}
 			}
@@ -336,33 +619,26 @@ next_line:
 	if scanner.indentationPending != 0 {
 		if scanner.indentationPending < 0 {
 			scanner.indentationPending++
-			pos = scanner.currentPosition
-			return &token.Token{
-				ID:          token.DEDENT,
-				LineStart:   pos.Line,
-				ColumnStart: pos.Column,
-				LineEnd:     pos.Line,
-				ColumnEnd:   pos.Column,
-				Literal:     "",
-			}
+			return scanner.indentationToken(token.DEDENT)
 		} else {
 			scanner.indentationPending--
-			pos = scanner.currentPosition
-			return &token.Token{
-				ID:          token.INDENT,
-				LineStart:   pos.Line,
-				ColumnStart: pos.Column,
-				LineEnd:     pos.Line,
-				ColumnEnd:   pos.Column + 4,
-				Literal:     "    ",
-			}
+			return scanner.indentationToken(token.INDENT)
 		}
 	}
 
+	// Check if we are closing an async function
+	if scanner.asyncDef && !blankline && scanner.indentationLevel == 0 &&
+		scanner.asyncDefNewline && scanner.asyncDefIndent >= scanner.indentationCurrent {
+		scanner.asyncDef = false
+		scanner.asyncDefIndent = 0
+		scanner.asyncDefNewline = false
+	}
+
+again:
 	pos = scanner.nextPosition()
 	// skip spaces
 	for {
-		if pos.Char != ' ' && pos.Char != '\t' {
+		if pos.Char != ' ' && pos.Char != '\t' && pos.Char != '\f' {
 			break
 		}
 		pos = scanner.nextPosition()
@@ -381,41 +657,66 @@ next_line:
 	positions.Append(pos)
 	switch ch := pos.Char; {
 	case ch == EOF:
-		id := token.ENDMARKER
-		if scanner.state != errorcode.E_EOF {
-			id = token.ERRORTOKEN
+		if scanner.state == errorcode.E_INTR {
+			return scanner.errorToken(errorcode.E_INTR, pos)
+		} else if scanner.state != errorcode.E_EOF {
+			return scanner.errorToken(errorcode.E_TOKEN, pos)
 		}
-		return positions.AsToken(id)
+		if scanner.lineStarted {
+			// Input without a trailing newline still ends its last line
+			newline := scanner.newlineAtEOF()
+			scanner.atBol = true
+			scanner.unreadPosition(pos)
+			return newline
+		}
+		return positions.AsToken(token.ENDMARKER)
 	case IsIdentifierStart(ch):
 		// Parse Identifier
-		saw_b, saw_r, saw_u := false, false, false
+		sawB, sawR, sawU, sawF := false, false, false, false
 		for {
-			if !(saw_b || saw_u) && (ch == 'b' || ch == 'B') {
-				saw_b = true
-			} else if !(saw_b || saw_u || saw_r) && (ch == 'u' || ch == 'U') {
-				saw_u = true
-			} else if !(saw_r || saw_u) && (ch == 'r' || ch == 'R') {
-				saw_r = true
+			if !(sawB || sawU || sawF) && (ch == 'b' || ch == 'B') {
+				sawB = true
+			} else if !(sawB || sawU || sawR || sawF) && (ch == 'u' || ch == 'U') {
+				sawU = true
+			} else if !(sawR || sawU) && (ch == 'r' || ch == 'R') {
+				sawR = true
+			} else if scanner.version.AtLeast(Python36) && !(sawF || sawB || sawU) && (ch == 'f' || ch == 'F') {
+				sawF = true
 			} else {
 				break
 			}
 			pos = scanner.nextPosition()
 			if IsQuote(pos.Char) {
+				prefix := positions.String()
 				positions.Append(pos)
-				return scanner.parseQuoted(positions, pos.Char)
+				return scanner.parseQuoted(&positions, prefix, pos.Char)
+			}
+			if !IsIdentifierChar(pos.Char) {
+				break
 			}
-		}
-		pos = scanner.nextPosition()
-		for IsIdentifierChar(pos.Char) {
 			positions.Append(pos)
+			ch = pos.Char
+		}
+		if IsIdentifierChar(pos.Char) {
 			pos = scanner.nextPosition()
+			for IsIdentifierChar(pos.Char) {
+				positions.Append(pos)
+				pos = scanner.nextPosition()
+			}
 		}
 		scanner.unreadPosition(pos)
 
-		// Check for async/await
 		literal := positions.String()
+		if IsQuote(pos.Char) && !scanner.version.AtLeast(Python36) {
+			switch strings.ToLower(literal) {
+			case "f", "fr", "rf":
+				return scanner.featureErrorToken("f-string", Python36, positions.Start())
+			}
+		}
+
+		// Check for async/await
 		if literal == "async" || literal == "await" {
-			if scanner.asyncDef {
+			if scanner.asyncDef || scanner.version.AtLeast(Python37) {
 				switch literal {
 				case "async":
 					return positions.AsToken(token.ASYNC)
@@ -426,6 +727,7 @@ next_line:
 				nextToken := scanner.NextToken()
 				if nextToken.ID == token.NAME && nextToken.Literal == "def" {
 					scanner.asyncDef = true
+					scanner.asyncDefIndent = scanner.indentationCurrent
 					scanner.unreadToken(nextToken)
 					return positions.AsToken(token.ASYNC)
 				}
@@ -436,15 +738,18 @@ next_line:
 		return positions.AsToken(token.NAME)
 	case ch == '\n':
 		scanner.atBol = true
-		if blankline || scanner.indentationCurrent > 0 {
+		if blankline || scanner.indentationLevel > 0 {
 			goto next_line
 		}
+		if scanner.asyncDef {
+			// We are past the signature of an "async def"
+			scanner.asyncDefNewline = true
+		}
 		return positions.AsToken(token.NEWLINE)
 	case ch == '.':
 		pos2 := scanner.nextPosition()
 		if IsDigit(pos2.Char) {
-			positions.Append(pos2)
-			return scanner.parseNumber(positions, pos2.Char)
+			return scanner.parseFraction(&positions, pos2)
 		} else if pos2.Char == '.' {
 			positions.Append(pos2)
 			pos3 := scanner.nextPosition()
@@ -459,12 +764,23 @@ next_line:
 		return positions.AsToken(token.DOT)
 	case IsDigit(ch):
 		// Parse Number
-		return scanner.parseNumber(positions, ch)
+		return scanner.parseNumber(&positions)
 	case IsQuote(ch):
 		// Parse String
-		return scanner.parseQuoted(positions, ch)
+		return scanner.parseQuoted(&positions, "", ch)
 	case ch == '\\':
 		// Parse Continuation
+		pos = scanner.nextPosition()
+		if pos.Char != '\n' {
+			return scanner.errorToken(errorcode.E_LINECONT, pos)
+		}
+		pos = scanner.nextPosition()
+		if pos.Char == EOF {
+			return scanner.errorToken(errorcode.E_EOF, pos)
+		}
+		scanner.unreadPosition(pos)
+		positions = scanner.newPositions()
+		goto again
 	default:
 		// Two and Three character operators
 		pos2 := scanner.nextPosition()
@@ -484,6 +800,9 @@ next_line:
 	}
 	switch pos.Char {
 	case '(', '[', '{':
+		if scanner.indentationLevel >= MAXLEVEL {
+			return scanner.errorTokenWithMessage(errorcode.E_SYNTAX, pos, "too many nested parentheses")
+		}
 		// Increment indentation level
 		scanner.indentationLevel++
 		break
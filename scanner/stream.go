@@ -0,0 +1,129 @@
+package scanner
+
+import (
+	"context"
+
+	"github.com/brettlangdon/gython/errorcode"
+	"github.com/brettlangdon/gython/token"
+)
+
+// TokenStream reads tokens from a Scanner on demand and buffers them, so a
+// consumer can look ahead any number of tokens and backtrack to a mark.
+// Once the scanner returns ENDMARKER or ERRORTOKEN that token is repeated forever.
+type TokenStream struct {
+	ctx     context.Context
+	scanner *Scanner
+	buffer  []*token.Token
+	// index of the next token in buffer
+	index int
+	marks []int
+	done  bool
+}
+
+// Tokens returns a TokenStream over the tokens of scanner. When ctx is done
+// the stream ends with an ERRORTOKEN and Err returns ctx.Err().
+func (scanner *Scanner) Tokens(ctx context.Context) *TokenStream {
+	return &TokenStream{
+		ctx:     ctx,
+		scanner: scanner,
+		buffer:  make([]*token.Token, 0),
+		marks:   make([]int, 0),
+	}
+}
+
+// Stream scans in a new goroutine and sends every token on the returned
+// channel. The channel is closed after ENDMARKER or ERRORTOKEN, or once ctx is done.
+func (scanner *Scanner) Stream(ctx context.Context) <-chan *token.Token {
+	tokens := make(chan *token.Token)
+	go func() {
+		defer close(tokens)
+		stream := scanner.Tokens(ctx)
+		for {
+			tok := stream.Next()
+			select {
+			case tokens <- tok:
+			case <-ctx.Done():
+				return
+			}
+			if tok.ID == token.ENDMARKER || tok.ID == token.ERRORTOKEN {
+				return
+			}
+		}
+	}()
+	return tokens
+}
+
+// Next consumes and returns the next token
+func (stream *TokenStream) Next() *token.Token {
+	tok := stream.Peek(0)
+	if stream.index < len(stream.buffer) {
+		stream.index++
+	}
+	if !stream.done && len(stream.marks) == 0 && stream.index == len(stream.buffer) {
+		// Nothing can backtrack to the consumed tokens anymore
+		stream.buffer = stream.buffer[:0]
+		stream.index = 0
+	}
+	return tok
+}
+
+// Peek returns the token n tokens ahead without consuming anything, Peek(0) is the token Next returns
+func (stream *TokenStream) Peek(n int) *token.Token {
+	for !stream.done && stream.index+n >= len(stream.buffer) {
+		stream.scan()
+	}
+	if stream.index+n >= len(stream.buffer) {
+		return stream.buffer[len(stream.buffer)-1]
+	}
+	return stream.buffer[stream.index+n]
+}
+
+// Mark remembers the current position, to return to it with Reset
+func (stream *TokenStream) Mark() {
+	stream.marks = append(stream.marks, stream.index)
+}
+
+// Reset moves the stream back to the most recent mark and removes it
+func (stream *TokenStream) Reset() {
+	last := len(stream.marks) - 1
+	stream.index = stream.marks[last]
+	stream.marks = stream.marks[:last]
+}
+
+// Release removes the most recent mark without moving the stream
+func (stream *TokenStream) Release() {
+	stream.marks = stream.marks[:len(stream.marks)-1]
+}
+
+// Err returns why the stream ended with an ERRORTOKEN, or nil
+func (stream *TokenStream) Err() error {
+	err := stream.ScanError()
+	if err == nil {
+		return nil
+	}
+	if err.Code == errorcode.E_INTR && stream.ctx.Err() != nil {
+		return stream.ctx.Err()
+	}
+	return err
+}
+
+// ScanError returns the error of the final ERRORTOKEN, including cancellation, or nil
+func (stream *TokenStream) ScanError() *ScanError {
+	if !stream.done || stream.buffer[len(stream.buffer)-1].ID != token.ERRORTOKEN {
+		return nil
+	}
+	return stream.scanner.Err()
+}
+
+func (stream *TokenStream) scan() {
+	var tok *token.Token
+	if err := stream.ctx.Err(); err != nil {
+		tok = stream.scanner.errorTokenWithMessage(errorcode.E_INTR, stream.scanner.currentPosition, err.Error())
+	} else {
+		tok = stream.scanner.NextToken()
+	}
+	if tok.ID == token.ENDMARKER || tok.ID == token.ERRORTOKEN {
+		stream.done = true
+	}
+	stream.buffer = append(stream.buffer, tok)
+}
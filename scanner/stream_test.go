@@ -0,0 +1,110 @@
+package scanner
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/brettlangdon/gython/errorcode"
+	"github.com/brettlangdon/gython/token"
+)
+
+func TestTokenStreamMarks(t *testing.T) {
+	stream := NewBytesScanner([]byte("a b c d\n")).Tokens(context.Background())
+	next := func() string {
+		return stream.Next().Literal
+	}
+
+	if peeked := stream.Peek(2).Literal; peeked != "c" {
+		t.Errorf("Peek(2) = %q, want \"c\"", peeked)
+	}
+	stream.Mark()
+	next()
+	stream.Mark()
+	next()
+	next()
+	if peeked := stream.Peek(0).Literal; peeked != "d" {
+		t.Errorf("Peek(0) = %q, want \"d\"", peeked)
+	}
+	// The inner mark returns to b, the outer one to a
+	stream.Reset()
+	if literal := next(); literal != "b" {
+		t.Errorf("after the inner Reset Next = %q, want \"b\"", literal)
+	}
+	stream.Reset()
+	if literal := next(); literal != "a" {
+		t.Errorf("after the outer Reset Next = %q, want \"a\"", literal)
+	}
+
+	// Release keeps the position but drops the mark, so a Reset goes to the mark before it
+	stream.Mark()
+	next()
+	stream.Mark()
+	next()
+	stream.Release()
+	stream.Reset()
+	if literal := next(); literal != "b" {
+		t.Errorf("after Release and Reset Next = %q, want \"b\"", literal)
+	}
+
+	literals := []string{next(), next(), next(), next(), next()}
+	if want := []string{"c", "d", "\n", "", ""}; !reflect.DeepEqual(literals, want) {
+		t.Errorf("the rest of the stream is %q, want %q", literals, want)
+	}
+	if tok := stream.Peek(10); tok.ID != token.ENDMARKER {
+		t.Errorf("Peek past the end returned %s, want ENDMARKER", tok)
+	}
+	if err := stream.Err(); err != nil {
+		t.Errorf("Err() = %v at the end of the stream", err)
+	}
+}
+
+func TestTokenStreamError(t *testing.T) {
+	stream := NewBytesScanner([]byte("a 'b\n")).Tokens(context.Background())
+	stream.Next()
+	if tok := stream.Next(); tok.ID != token.ERRORTOKEN || stream.Next() != tok {
+		t.Errorf("an unterminated string returned %s and was not repeated", tok)
+	}
+	if err := stream.ScanError(); err == nil || err.Code != errorcode.E_EOLS {
+		t.Errorf("ScanError() = %v, want an E_EOLS error", err)
+	}
+}
+
+func TestTokenStreamCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := NewBytesScanner([]byte("a b\n")).Tokens(ctx)
+	if tok := stream.Next(); tok.Literal != "a" {
+		t.Errorf("Next() = %q before the context was cancelled", tok.Literal)
+	}
+	cancel()
+	if tok := stream.Next(); tok.ID != token.ERRORTOKEN {
+		t.Errorf("Next() = %s after the context was cancelled, want ERRORTOKEN", tok)
+	}
+	if err := stream.Err(); err != context.Canceled {
+		t.Errorf("Err() = %v, want %v", err, context.Canceled)
+	}
+	if err := stream.ScanError(); err == nil || err.Code != errorcode.E_INTR {
+		t.Errorf("ScanError() = %v, want an E_INTR error", err)
+	}
+}
+
+func TestStream(t *testing.T) {
+	literals := make([]string, 0)
+	for tok := range NewBytesScanner([]byte("a = 1\n")).Stream(context.Background()) {
+		literals = append(literals, tok.Literal)
+	}
+	if want := []string{"a", "=", "1", "\n", ""}; !reflect.DeepEqual(literals, want) {
+		t.Errorf("Stream sent %q, want %q", literals, want)
+	}
+
+	// Once the context is done the channel is closed before the end of the source
+	ctx, cancel := context.WithCancel(context.Background())
+	tokens := NewBytesScanner([]byte("a = 1\n")).Stream(ctx)
+	<-tokens
+	cancel()
+	for tok := range tokens {
+		if tok.ID == token.ENDMARKER {
+			t.Errorf("Stream sent ENDMARKER after the context was cancelled")
+		}
+	}
+}
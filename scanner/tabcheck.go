@@ -0,0 +1,14 @@
+package scanner
+
+// TabCheck controls how the scanner reacts to indentation whose meaning
+// depends on the tab size, mirroring CPython's -t and -tt flags
+type TabCheck int
+
+const (
+	// TabCheckOff ignores inconsistent use of tabs and spaces
+	TabCheckOff TabCheck = iota
+	// TabCheckWarn records a single warning and keeps scanning (-t)
+	TabCheckWarn
+	// TabCheckError stops scanning with a TabError (-tt, the Python 3 default)
+	TabCheckError
+)
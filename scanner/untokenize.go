@@ -0,0 +1,160 @@
+package scanner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brettlangdon/gython/token"
+)
+
+// Untokenize converts a token stream back into source text, the inverse of
+// NextToken. When tokens carry positions the original layout is reproduced,
+// tokens without a position (LineStart of 0) are joined with minimal spacing
+// instead, mirroring Python's tokenize.untokenize.
+func Untokenize(tokens []*token.Token) (string, error) {
+	untokenizer := &untokenizer{
+		prevLine:   1,
+		prevColumn: 0,
+		output:     make([]string, 0),
+	}
+
+	indents := make([]string, 0)
+	startline := false
+	for i, tok := range tokens {
+		if tok.LineStart == 0 {
+			untokenizer.compat(tokens[i:])
+			break
+		}
+
+		switch tok.ID {
+		case token.ENDMARKER:
+			return untokenizer.String(), nil
+		case token.INDENT:
+			indents = append(indents, tok.Literal)
+			continue
+		case token.DEDENT:
+			if len(indents) > 0 {
+				indents = indents[:len(indents)-1]
+			}
+			continue
+		}
+
+		if err := untokenizer.addNewlines(tok.LineStart, tok.ColumnStart, startline); err != nil {
+			return "", err
+		}
+		if startline && tok.ID != token.NEWLINE && len(indents) > 0 {
+			indent := indents[len(indents)-1]
+			if tok.ColumnStart >= len(indent) {
+				untokenizer.output = append(untokenizer.output, indent)
+				untokenizer.prevColumn = len(indent)
+			}
+		}
+		if tok.ID == token.NEWLINE {
+			// Anything between the last token and the newline was a comment
+			untokenizer.output = append(untokenizer.output, newlineLiteral(tok))
+			untokenizer.prevLine, untokenizer.prevColumn = tok.LineEnd+1, 0
+			startline = true
+			continue
+		}
+		untokenizer.addSpaces(tok.ColumnStart)
+		startline = false
+		untokenizer.output = append(untokenizer.output, tok.Literal)
+		untokenizer.prevLine, untokenizer.prevColumn = tok.LineEnd, tok.ColumnEnd
+	}
+
+	return untokenizer.String(), nil
+}
+
+type untokenizer struct {
+	prevLine   int
+	prevColumn int
+	output     []string
+}
+
+func (untokenizer *untokenizer) String() string {
+	return strings.Join(untokenizer.output, "")
+}
+
+// addNewlines moves the output down to line. Lines skipped inside a logical
+// line are joined with a backslash continuation, lines skipped between
+// statements were blank or comments and are written as plain newlines.
+func (untokenizer *untokenizer) addNewlines(line int, column int, startline bool) error {
+	if line < untokenizer.prevLine || (line == untokenizer.prevLine && column < untokenizer.prevColumn) {
+		return fmt.Errorf(
+			"start (%d,%d) precedes previous end (%d,%d)",
+			line, column, untokenizer.prevLine, untokenizer.prevColumn,
+		)
+	}
+
+	if lineOffset := line - untokenizer.prevLine; lineOffset > 0 {
+		newline := "\\\n"
+		if startline {
+			newline = "\n"
+		}
+		untokenizer.output = append(untokenizer.output, strings.Repeat(newline, lineOffset))
+		untokenizer.prevColumn = 0
+	}
+	return nil
+}
+
+// addSpaces pads the current line up to column
+func (untokenizer *untokenizer) addSpaces(column int) {
+	if columnOffset := column - untokenizer.prevColumn; columnOffset > 0 {
+		untokenizer.output = append(untokenizer.output, strings.Repeat(" ", columnOffset))
+	}
+}
+
+// compat writes tokens using only their IDs and literals
+func (untokenizer *untokenizer) compat(tokens []*token.Token) {
+	indents := make([]string, 0)
+	startline := tokens[0].ID == token.NEWLINE
+	prevString := false
+
+	for _, tok := range tokens {
+		literal := tok.Literal
+		switch tok.ID {
+		case token.NAME, token.NUMBER, token.ASYNC, token.AWAIT:
+			literal += " "
+		case token.NEWLINE:
+			literal = newlineLiteral(tok)
+		}
+
+		// Insert a space between two consecutive strings
+		if tok.ID == token.STRING {
+			if prevString {
+				literal = " " + literal
+			}
+			prevString = true
+		} else {
+			prevString = false
+		}
+
+		switch tok.ID {
+		case token.ENDMARKER:
+			return
+		case token.INDENT:
+			indents = append(indents, tok.Literal)
+			continue
+		case token.DEDENT:
+			if len(indents) > 0 {
+				indents = indents[:len(indents)-1]
+			}
+			continue
+		case token.NEWLINE:
+			startline = true
+		default:
+			if startline && len(indents) > 0 {
+				untokenizer.output = append(untokenizer.output, indents[len(indents)-1])
+			}
+			startline = false
+		}
+		untokenizer.output = append(untokenizer.output, literal)
+	}
+}
+
+func newlineLiteral(tok *token.Token) string {
+	if tok.Literal == "" {
+		return "\n"
+	}
+	return tok.Literal
+}
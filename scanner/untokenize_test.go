@@ -0,0 +1,76 @@
+package scanner
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/brettlangdon/gython/token"
+)
+
+var untokenizeTests = []string{
+	"x = 1\n",
+	"if a:  # comment\n    x = (1 +\n         2)  # another\n    y = \\\n        3\n\n# only a comment\n    # an indented comment\nz = 1\n",
+	"def f(a, b):\n    if a:\n        return b\n    elif b:\n\n        pass\n    return a\nclass A:\n\tpass\n",
+	"s = '''one\ntwo''' + \"x\"\nt = b'\\x00' r'\\d'\n",
+	"values = [\n    1,\n    2,\n]\nlookup = {'a': 1}\n",
+}
+
+func TestUntokenizeRoundTrip(t *testing.T) {
+	for _, source := range untokenizeTests {
+		tokens := scanAll(t, source)
+		untokenized, err := Untokenize(tokens)
+		if err != nil {
+			t.Errorf("Untokenize(%q) returned an error: %s", source, err)
+			continue
+		}
+		if want, got := tokenKinds(tokens), tokenKinds(scanAll(t, untokenized)); !reflect.DeepEqual(want, got) {
+			t.Errorf("%q untokenized to %q\nwant %v\ngot  %v", source, untokenized, want, got)
+		}
+	}
+}
+
+func TestUntokenizeKeepsLayout(t *testing.T) {
+	tests := []struct {
+		source      string
+		untokenized string
+	}{
+		{"x  =  1 # comment\n", "x  =  1\n"},
+		{"if a:\n    x = (1 +\n         2)\n", "if a:\n    x = (1 +\\\n         2)\n"},
+		// The NEWLINE the scanner adds at the end of the source is written out
+		{"x = 1", "x = 1\n"},
+	}
+	for _, test := range tests {
+		untokenized, err := Untokenize(scanAll(t, test.source))
+		if err != nil || untokenized != test.untokenized {
+			t.Errorf("Untokenize(%q) = %q, %v, want %q", test.source, untokenized, err, test.untokenized)
+		}
+	}
+}
+
+func TestUntokenizeWithoutPositions(t *testing.T) {
+	for _, source := range untokenizeTests {
+		tokens := scanAll(t, source)
+		stripped := make([]*token.Token, 0, len(tokens))
+		for _, tok := range tokens {
+			stripped = append(stripped, &token.Token{ID: tok.ID, Literal: tok.Literal})
+		}
+		untokenized, err := Untokenize(stripped)
+		if err != nil {
+			t.Errorf("Untokenize(%q) without positions returned an error: %s", source, err)
+			continue
+		}
+		if want, got := tokenKinds(tokens), tokenKinds(scanAll(t, untokenized)); !reflect.DeepEqual(want, got) {
+			t.Errorf("%q untokenized without positions to %q\nwant %v\ngot  %v", source, untokenized, want, got)
+		}
+	}
+}
+
+func TestUntokenizeOutOfOrder(t *testing.T) {
+	tokens := scanAll(t, "a = b\n")
+	tokens[0], tokens[2] = tokens[2], tokens[0]
+	_, err := Untokenize(tokens)
+	if err == nil || !strings.Contains(err.Error(), "precedes previous end") {
+		t.Errorf("Untokenize of out of order tokens returned %v", err)
+	}
+}
@@ -0,0 +1,34 @@
+package scanner
+
+import "fmt"
+
+// Version is the Python language version the scanner should accept
+type Version struct {
+	Major int
+	Minor int
+}
+
+var (
+	Python35 = Version{Major: 3, Minor: 5}
+	Python36 = Version{Major: 3, Minor: 6}
+	Python37 = Version{Major: 3, Minor: 7}
+
+	DefaultVersion = Python35
+)
+
+// AtLeast reports whether version is the same as or newer than other
+func (version Version) AtLeast(other Version) bool {
+	if version.Major != other.Major {
+		return version.Major > other.Major
+	}
+	return version.Minor >= other.Minor
+}
+
+func (version Version) String() string {
+	return fmt.Sprintf("%d.%d", version.Major, version.Minor)
+}
+
+// FeatureMessage is the error reported when source uses a feature newer than the target version
+func FeatureMessage(feature string, required Version) string {
+	return fmt.Sprintf("%s requires Python %s", feature, required)
+}
@@ -88,4 +88,7 @@ const (
 	ENCODING_DECL      SymbolID = 338
 	YIELD_EXPR         SymbolID = 339
 	YIELD_ARG          SymbolID = 340
+
+	// Python 3.6+
+	ANNASSIGN SymbolID = 341
 )
@@ -86,4 +86,5 @@ var SymbolNames = [...]string{
 	ENCODING_DECL:      "ENCODING_DECL",
 	YIELD_EXPR:         "YIELD_EXPR",
 	YIELD_ARG:          "YIELD_ARG",
+	ANNASSIGN:          "ANNASSIGN",
 }
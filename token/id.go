@@ -66,3 +66,8 @@ const (
 func (id TokenID) String() string {
 	return TokenNames[id]
 }
+
+// IsOperator reports whether id is an operator or delimiter, which Python's tokenize module reports as OP
+func (id TokenID) IsOperator() bool {
+	return id >= LPAR && id <= OP
+}
@@ -5,10 +5,13 @@ import "fmt"
 type Token struct {
 	ColumnEnd   int
 	LineEnd     int
+	OffsetEnd   int
 	ID          TokenID
 	Literal     string
+	Prefix      string
 	ColumnStart int
 	LineStart   int
+	OffsetStart int
 }
 
 func (token *Token) String() string {
@@ -23,18 +26,26 @@ func (token *Token) End() []int {
 	return []int{token.LineEnd, token.ColumnEnd}
 }
 
+// Span returns the byte offsets of the start and end of the token in the source
+func (token *Token) Span() (int, int) {
+	return token.OffsetStart, token.OffsetEnd
+}
+
 func (token *Token) IsLiteral(literal string) bool {
 	return token.ID == NAME && token.Literal == literal
 }
 
 func (token *Token) Repr() string {
 	return fmt.Sprintf(
-		"Token{ID: %#v, Literal: %#v, LineStart: %#v, ColumnStart: %#v, LineEnd: %#v, ColumnEnd: %#v}",
+		"Token{ID: %#v, Literal: %#v, Prefix: %#v, LineStart: %#v, ColumnStart: %#v, OffsetStart: %#v, LineEnd: %#v, ColumnEnd: %#v, OffsetEnd: %#v}",
 		token.ID,
 		token.Literal,
+		token.Prefix,
 		token.LineStart,
 		token.ColumnStart,
+		token.OffsetStart,
 		token.LineEnd,
 		token.ColumnEnd,
+		token.OffsetEnd,
 	)
 }
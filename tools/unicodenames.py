@@ -0,0 +1,89 @@
+"""Generates ast/unicodenames_table.go from the Unicode Character Database.
+
+gython follows Python 3.5, whose \\N{name} escapes know the names of Unicode 8.0.0.
+The database is read from a directory or URL holding UnicodeData.txt and
+NameAliases.txt, so the table does not depend on the Python running this script:
+
+    python3 unicodenames.py https://www.unicode.org/Public/8.0.0/ucd > ../ast/unicodenames_table.go
+"""
+import re
+import sys
+import urllib.request
+
+# Names computed by the lookup instead of being listed
+ALGORITHMIC = ("CJK UNIFIED IDEOGRAPH-", "HANGUL SYLLABLE ")
+
+
+def read_lines(ucd, filename):
+    """Returns the lines of a database file, from a URL or a local directory"""
+    path = ucd.rstrip("/") + "/" + filename
+    if re.match(r"[a-z]+://", path):
+        with urllib.request.urlopen(path) as response:
+            return response.read().decode("utf-8").splitlines()
+    with open(path, encoding="utf-8") as data:
+        return data.read().splitlines()
+
+
+def records(lines):
+    """Yields the semicolon separated fields of every line which is not a comment"""
+    for line in lines:
+        line = line.split("#", 1)[0].strip()
+        if line:
+            yield [field.strip() for field in line.split(";")]
+
+
+def main(ucd):
+    names = []
+    ideographs = []
+    previous = None
+    for fields in records(read_lines(ucd, "UnicodeData.txt")):
+        code, name = int(fields[0], 16), fields[1]
+        if name.startswith("<CJK Ideograph"):
+            if name.endswith("First>"):
+                ideographs.append([code, code])
+            else:
+                ideographs[-1][1] = code
+            continue
+        # Ranges and <control> have no name, Hangul syllables are named by the lookup
+        if name.startswith("<") or name.startswith(ALGORITHMIC[1]):
+            continue
+        if name.startswith(ALGORITHMIC[0]):
+            ideographs.append([code, code])
+            continue
+        if previous is None or code != previous + 1:
+            names.append("@%X" % code)
+        names.append(name)
+        previous = code
+
+    alias_lines = read_lines(ucd, "NameAliases.txt")
+    version = re.search(r"NameAliases-([0-9.]+)\.txt", alias_lines[0]).group(1)
+
+    print("// Code generated by unicodenames.py from Unicode %s. DO NOT EDIT." % version)
+    print()
+    print("package ast")
+    print()
+    print("// unifiedIdeographs are the ranges named CJK UNIFIED IDEOGRAPH-XXXX")
+    print("var unifiedIdeographs = [][2]rune{")
+    for low, high in ideographs:
+        print("\t{0x%X, 0x%X}," % (low, high))
+    print("}")
+    print()
+    print("// unicodeNameAliases are the formal aliases from NameAliases.txt, as \"XXXX NAME\" lines")
+    print("const unicodeNameAliases = `")
+    for fields in records(alias_lines):
+        print("%X %s" % (int(fields[0], 16), fields[1]))
+    print("`")
+    print()
+    print("// unicodeNames are the character names in code point order, one per line. A line")
+    print("// \"@XXXX\" gives the code point of the next name, every other name is for the code")
+    print("// point after the one before it.")
+    print("const unicodeNames = `")
+    for name in names:
+        print(name)
+    print("`")
+
+
+if __name__ == "__main__":
+    if len(sys.argv) != 2:
+        sys.exit("usage: unicodenames.py UCD_DIRECTORY_OR_URL")
+    main(sys.argv[1])